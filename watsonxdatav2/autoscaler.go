@@ -0,0 +1,535 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// AutoscalerMetricsSource : The load signal an Autoscaler scales on
+// Load returns the current load of the engine in the unit of AutoscalerOptions.TargetLoadPerNode, for example
+// pending Spark applications or queued queries.
+type AutoscalerMetricsSource interface {
+	Load(ctx context.Context) (float64, error)
+}
+
+// AutoscalerMetricsSourceFunc : Adapter to use a function as an AutoscalerMetricsSource.
+type AutoscalerMetricsSourceFunc func(ctx context.Context) (float64, error)
+
+// Load calls the function.
+func (f AutoscalerMetricsSourceFunc) Load(ctx context.Context) (float64, error) {
+	return f(ctx)
+}
+
+// SparkApplicationsMetricsSource : An AutoscalerMetricsSource counting the Spark applications of an engine that are
+// in one of the given states.
+type SparkApplicationsMetricsSource struct {
+	service *WatsonxDataV2
+
+	// engine id.
+	EngineID string
+
+	// Application states to count, compared case-insensitively.
+	States []string
+
+	// CRN.
+	AuthInstanceID *string
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSparkApplicationsMetricsSource : Instantiate SparkApplicationsMetricsSource
+// Without states, applications that are accepted, submitted or waiting are counted.
+func (watsonxData *WatsonxDataV2) NewSparkApplicationsMetricsSource(engineID string, states ...string) *SparkApplicationsMetricsSource {
+	if len(states) == 0 {
+		states = []string{"accepted", "submitted", "waiting"}
+	}
+	return &SparkApplicationsMetricsSource{
+		service:  watsonxData,
+		EngineID: engineID,
+		States:   states,
+	}
+}
+
+// Load returns the number of applications in one of the states.
+func (source *SparkApplicationsMetricsSource) Load(ctx context.Context) (float64, error) {
+	collection, _, err := source.service.ListSparkEngineApplicationsWithContext(ctx, &ListSparkEngineApplicationsOptions{
+		EngineID:       core.StringPtr(source.EngineID),
+		AuthInstanceID: source.AuthInstanceID,
+		Headers:        source.Headers,
+	})
+	if err != nil {
+		return 0, core.RepurposeSDKProblem(err, "list-applications-error")
+	}
+	count := 0
+	for _, application := range collection.Applications {
+		for _, state := range source.States {
+			if application.State != nil && strings.EqualFold(*application.State, state) {
+				count++
+				break
+			}
+		}
+	}
+	return float64(count), nil
+}
+
+// AutoscalerDecision : The outcome of one Autoscaler evaluation.
+type AutoscalerDecision struct {
+	// When the evaluation ran.
+	Time time.Time
+
+	// Load reported by the metrics source.
+	Load float64
+
+	// Worker nodes before the evaluation.
+	CurrentNodes int64
+
+	// Worker nodes after the evaluation. Equal to CurrentNodes unless the engine was scaled.
+	DesiredNodes int64
+
+	// Whether the engine was scaled up, scaled down or left as is.
+	Action string
+
+	// Why the engine was or was not scaled.
+	Reason string
+}
+
+// Constants associated with the AutoscalerDecision.Action property.
+// Whether the engine was scaled up, scaled down or left as is.
+const (
+	AutoscalerDecision_Action_None      = "none"
+	AutoscalerDecision_Action_ScaleDown = "scale_down"
+	AutoscalerDecision_Action_ScaleUp   = "scale_up"
+)
+
+// Autoscaler : Scales a Presto, Prestissimo or Spark engine on a load signal
+// Each evaluation reads the load and the current worker count. The desired count is load / TargetLoadPerNode
+// rounded up and kept within MinNodes and MaxNodes. The engine is scaled only if the load per node is outside
+// TargetLoadPerNode by more than Tolerance (hysteresis), and not within the cooldown of the previous scale in the
+// same direction or of any scale in the opposite direction. A count outside the bounds is always corrected.
+type Autoscaler struct {
+	service       *WatsonxDataV2
+	options       *AutoscalerOptions
+	lastScaleUp   time.Time
+	lastScaleDown time.Time
+	mutex         sync.Mutex
+}
+
+// NewAutoscaler : Instantiate Autoscaler.
+func (watsonxData *WatsonxDataV2) NewAutoscaler(autoscalerOptions *AutoscalerOptions) (autoscaler *Autoscaler, err error) {
+	err = core.ValidateNotNil(autoscalerOptions, "autoscalerOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(autoscalerOptions, "autoscalerOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := autoscalerOptions
+	switch *opts.EngineType {
+	case AutoscalerOptions_EngineType_Prestissimo, AutoscalerOptions_EngineType_Presto, AutoscalerOptions_EngineType_Spark:
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported engine type '%s'", *opts.EngineType), "invalid-engine-type", common.GetComponentInfo())
+		return
+	}
+	if *opts.MinNodes < 1 || *opts.MinNodes > *opts.MaxNodes {
+		err = core.SDKErrorf(nil, fmt.Sprintf("node bounds must satisfy 1 <= min (%d) <= max (%d)", *opts.MinNodes, *opts.MaxNodes), "invalid-bounds", common.GetComponentInfo())
+		return
+	}
+	if *opts.TargetLoadPerNode <= 0 {
+		err = core.SDKErrorf(nil, "target load per node must be positive", "invalid-target", common.GetComponentInfo())
+		return
+	}
+	if opts.Tolerance != nil && (*opts.Tolerance < 0 || *opts.Tolerance >= 1) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("tolerance must be in [0,1), got %g", *opts.Tolerance), "invalid-tolerance", common.GetComponentInfo())
+		return
+	}
+	autoscaler = &Autoscaler{
+		service: watsonxData,
+		options: opts,
+	}
+	return
+}
+
+// Run evaluates the engine every interval until ctx is done, and returns the context error. Evaluation errors are
+// passed to onError, if set, and do not stop the loop.
+func (autoscaler *Autoscaler) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case tick := <-ticker.C:
+			if _, err := autoscaler.Evaluate(ctx, tick); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// Evaluate reads the load and the worker count and scales the engine if needed. now is used for the cooldowns.
+func (autoscaler *Autoscaler) Evaluate(ctx context.Context, now time.Time) (decision *AutoscalerDecision, err error) {
+	autoscaler.mutex.Lock()
+	defer autoscaler.mutex.Unlock()
+	opts := autoscaler.options
+
+	load, err := opts.Metrics.Load(ctx)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "metrics-error", common.GetComponentInfo())
+		return
+	}
+	if load < 0 || math.IsNaN(load) || math.IsInf(load, 0) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the metrics source returned an invalid load %v", load), "invalid-load", common.GetComponentInfo())
+		return
+	}
+	current, nodeType, err := autoscaler.workers(ctx)
+	if err != nil {
+		return
+	}
+	decision = &AutoscalerDecision{
+		Time:         now,
+		Load:         load,
+		CurrentNodes: current,
+		DesiredNodes: current,
+		Action:       AutoscalerDecision_Action_None,
+	}
+
+	desired := int64(math.Ceil(load / *opts.TargetLoadPerNode))
+	if desired < *opts.MinNodes {
+		desired = *opts.MinNodes
+	}
+	if desired > *opts.MaxNodes {
+		desired = *opts.MaxNodes
+	}
+	tolerance := 0.0
+	if opts.Tolerance != nil {
+		tolerance = *opts.Tolerance
+	}
+	loadPerNode := math.Inf(1)
+	if current > 0 {
+		loadPerNode = load / float64(current)
+	}
+	outOfBounds := current < *opts.MinNodes || current > *opts.MaxNodes
+
+	switch {
+	case desired == current:
+		decision.Reason = "at desired size"
+		return
+	case !outOfBounds && desired > current && loadPerNode <= *opts.TargetLoadPerNode*(1+tolerance):
+		decision.Reason = "load within tolerance"
+		return
+	case !outOfBounds && desired < current && loadPerNode >= *opts.TargetLoadPerNode*(1-tolerance):
+		decision.Reason = "load within tolerance"
+		return
+	}
+	if !outOfBounds {
+		if remaining := autoscaler.cooldown(now, desired > current); remaining > 0 {
+			decision.Reason = fmt.Sprintf("in cooldown for another %s", remaining)
+			return
+		}
+	}
+
+	// A dry run does not scale, so it starts no cooldown.
+	dryRun := opts.DryRun != nil && *opts.DryRun
+	if !dryRun {
+		if err = autoscaler.scale(ctx, desired, nodeType); err != nil {
+			return
+		}
+	}
+	decision.DesiredNodes = desired
+	if desired > current {
+		decision.Action = AutoscalerDecision_Action_ScaleUp
+		if !dryRun {
+			autoscaler.lastScaleUp = now
+		}
+	} else {
+		decision.Action = AutoscalerDecision_Action_ScaleDown
+		if !dryRun {
+			autoscaler.lastScaleDown = now
+		}
+	}
+	decision.Reason = fmt.Sprintf("load %g needs %d nodes", load, desired)
+	if outOfBounds {
+		decision.Reason = fmt.Sprintf("%d nodes is outside %d-%d", current, *opts.MinNodes, *opts.MaxNodes)
+	}
+	return
+}
+
+// cooldown returns how long a scale in the given direction must still wait. A scale up waits for the scale up
+// cooldown after a scale up and the scale down cooldown after a scale down, and the reverse for a scale down.
+func (autoscaler *Autoscaler) cooldown(now time.Time, up bool) (remaining time.Duration) {
+	opts := autoscaler.options
+	same, opposite := autoscaler.lastScaleDown, autoscaler.lastScaleUp
+	sameCooldown, oppositeCooldown := opts.ScaleDownCooldown, opts.ScaleUpCooldown
+	if up {
+		same, opposite = opposite, same
+		sameCooldown, oppositeCooldown = oppositeCooldown, sameCooldown
+	}
+	if !same.IsZero() {
+		if wait := same.Add(sameCooldown).Sub(now); wait > remaining {
+			remaining = wait
+		}
+	}
+	if !opposite.IsZero() {
+		if wait := opposite.Add(oppositeCooldown).Sub(now); wait > remaining {
+			remaining = wait
+		}
+	}
+	return
+}
+
+// workers returns the current worker count and node type of the engine. It is an error if the engine does not
+// report its worker count, or, for Presto and Prestissimo, its node type when WorkerNodeType is not set.
+func (autoscaler *Autoscaler) workers(ctx context.Context) (count int64, nodeType *string, err error) {
+	watsonxData, opts := autoscaler.service, autoscaler.options
+	var quantity *int64
+	switch *opts.EngineType {
+	case AutoscalerOptions_EngineType_Presto:
+		var engine *PrestoEngine
+		engine, _, err = watsonxData.GetPrestoEngineWithContext(ctx, &GetPrestoEngineOptions{EngineID: opts.EngineID, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+		if err == nil && engine.Worker != nil {
+			quantity, nodeType = engine.Worker.Quantity, engine.Worker.NodeType
+		}
+	case AutoscalerOptions_EngineType_Prestissimo:
+		var engine *PrestissimoEngine
+		engine, _, err = watsonxData.GetPrestissimoEngineWithContext(ctx, &GetPrestissimoEngineOptions{EngineID: opts.EngineID, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+		if err == nil && engine.Worker != nil {
+			quantity, nodeType = engine.Worker.Quantity, engine.Worker.NodeType
+		}
+	case AutoscalerOptions_EngineType_Spark:
+		var engine *SparkEngine
+		engine, _, err = watsonxData.GetSparkEngineWithContext(ctx, &GetSparkEngineOptions{EngineID: opts.EngineID, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+		if err == nil && engine.EngineDetails != nil && engine.EngineDetails.ScaleConfig != nil {
+			scaleConfig := engine.EngineDetails.ScaleConfig
+			quantity = scaleConfig.CurrentNumberOfNodes
+			if int64Value(quantity) == 0 && scaleConfig.NumberOfNodes != nil {
+				quantity = scaleConfig.NumberOfNodes
+			}
+			nodeType = scaleConfig.NodeType
+		}
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-engine-error")
+		return
+	}
+	if opts.WorkerNodeType != nil {
+		nodeType = opts.WorkerNodeType
+	}
+	if quantity == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("engine %s does not report its worker count", *opts.EngineID), "unknown-workers", common.GetComponentInfo())
+		return
+	}
+	if *opts.EngineType != AutoscalerOptions_EngineType_Spark && (nodeType == nil || *nodeType == "") {
+		err = core.SDKErrorf(nil, fmt.Sprintf("engine %s does not report its worker node type; set WorkerNodeType", *opts.EngineID), "unknown-workers", common.GetComponentInfo())
+		return
+	}
+	count = *quantity
+	return
+}
+
+// scale sets the worker count of the engine.
+func (autoscaler *Autoscaler) scale(ctx context.Context, workers int64, nodeType *string) (err error) {
+	watsonxData, opts := autoscaler.service, autoscaler.options
+	switch *opts.EngineType {
+	case AutoscalerOptions_EngineType_Presto:
+		_, _, err = watsonxData.ScalePrestoEngineWithContext(ctx, &ScalePrestoEngineOptions{
+			EngineID:       opts.EngineID,
+			Worker:         &NodeDescription{NodeType: nodeType, Quantity: core.Int64Ptr(workers)},
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+	case AutoscalerOptions_EngineType_Prestissimo:
+		_, _, err = watsonxData.ScalePrestissimoEngineWithContext(ctx, &ScalePrestissimoEngineOptions{
+			EngineID:       opts.EngineID,
+			Worker:         &PrestissimoNodeDescriptionBody{NodeType: nodeType, Quantity: core.Int64Ptr(workers)},
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+	case AutoscalerOptions_EngineType_Spark:
+		_, _, err = watsonxData.ScaleSparkEngineWithContext(ctx, &ScaleSparkEngineOptions{
+			EngineID:       opts.EngineID,
+			NumberOfNodes:  core.Int64Ptr(workers),
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "scale-engine-error")
+	}
+	return
+}
+
+// int64Value returns the value of p, or 0 if p is nil.
+func int64Value(p *int64) int64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
+// AutoscalerOptions : The NewAutoscaler options.
+type AutoscalerOptions struct {
+	// Engine type.
+	EngineType *string `json:"engine_type" validate:"required"`
+
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Source of the load.
+	Metrics AutoscalerMetricsSource `json:"-" validate:"required"`
+
+	// Minimum number of worker nodes.
+	MinNodes *int64 `json:"min_nodes" validate:"required"`
+
+	// Maximum number of worker nodes.
+	MaxNodes *int64 `json:"max_nodes" validate:"required"`
+
+	// Load a single worker node should handle.
+	TargetLoadPerNode *float64 `json:"target_load_per_node" validate:"required"`
+
+	// Fraction by which the load per node may exceed or fall short of TargetLoadPerNode before the engine is scaled,
+	// at least 0 and below 1. Defaults to 0.
+	Tolerance *float64 `json:"tolerance,omitempty"`
+
+	// Time to wait after a scale up before scaling up again or scaling down.
+	ScaleUpCooldown time.Duration `json:"scale_up_cooldown,omitempty"`
+
+	// Time to wait after a scale down before scaling down again or scaling up.
+	ScaleDownCooldown time.Duration `json:"scale_down_cooldown,omitempty"`
+
+	// Node type for Presto and Prestissimo scale requests. Defaults to the current worker node type.
+	WorkerNodeType *string `json:"worker_node_type,omitempty"`
+
+	// Only compute decisions, without scaling the engine.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// Constants associated with the AutoscalerOptions.EngineType property.
+// Engine type.
+const (
+	AutoscalerOptions_EngineType_Prestissimo = "prestissimo"
+	AutoscalerOptions_EngineType_Presto      = "presto"
+	AutoscalerOptions_EngineType_Spark       = "spark"
+)
+
+// NewAutoscalerOptions : Instantiate AutoscalerOptions
+func (*WatsonxDataV2) NewAutoscalerOptions(engineType string, engineID string, metrics AutoscalerMetricsSource, minNodes int64, maxNodes int64, targetLoadPerNode float64) *AutoscalerOptions {
+	return &AutoscalerOptions{
+		EngineType:        core.StringPtr(engineType),
+		EngineID:          core.StringPtr(engineID),
+		Metrics:           metrics,
+		MinNodes:          core.Int64Ptr(minNodes),
+		MaxNodes:          core.Int64Ptr(maxNodes),
+		TargetLoadPerNode: core.Float64Ptr(targetLoadPerNode),
+	}
+}
+
+// SetEngineType : Allow user to set EngineType
+func (_options *AutoscalerOptions) SetEngineType(engineType string) *AutoscalerOptions {
+	_options.EngineType = core.StringPtr(engineType)
+	return _options
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *AutoscalerOptions) SetEngineID(engineID string) *AutoscalerOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetMetrics : Allow user to set Metrics
+func (_options *AutoscalerOptions) SetMetrics(metrics AutoscalerMetricsSource) *AutoscalerOptions {
+	_options.Metrics = metrics
+	return _options
+}
+
+// SetMinNodes : Allow user to set MinNodes
+func (_options *AutoscalerOptions) SetMinNodes(minNodes int64) *AutoscalerOptions {
+	_options.MinNodes = core.Int64Ptr(minNodes)
+	return _options
+}
+
+// SetMaxNodes : Allow user to set MaxNodes
+func (_options *AutoscalerOptions) SetMaxNodes(maxNodes int64) *AutoscalerOptions {
+	_options.MaxNodes = core.Int64Ptr(maxNodes)
+	return _options
+}
+
+// SetTargetLoadPerNode : Allow user to set TargetLoadPerNode
+func (_options *AutoscalerOptions) SetTargetLoadPerNode(targetLoadPerNode float64) *AutoscalerOptions {
+	_options.TargetLoadPerNode = core.Float64Ptr(targetLoadPerNode)
+	return _options
+}
+
+// SetTolerance : Allow user to set Tolerance
+func (_options *AutoscalerOptions) SetTolerance(tolerance float64) *AutoscalerOptions {
+	_options.Tolerance = core.Float64Ptr(tolerance)
+	return _options
+}
+
+// SetScaleUpCooldown : Allow user to set ScaleUpCooldown
+func (_options *AutoscalerOptions) SetScaleUpCooldown(scaleUpCooldown time.Duration) *AutoscalerOptions {
+	_options.ScaleUpCooldown = scaleUpCooldown
+	return _options
+}
+
+// SetScaleDownCooldown : Allow user to set ScaleDownCooldown
+func (_options *AutoscalerOptions) SetScaleDownCooldown(scaleDownCooldown time.Duration) *AutoscalerOptions {
+	_options.ScaleDownCooldown = scaleDownCooldown
+	return _options
+}
+
+// SetWorkerNodeType : Allow user to set WorkerNodeType
+func (_options *AutoscalerOptions) SetWorkerNodeType(workerNodeType string) *AutoscalerOptions {
+	_options.WorkerNodeType = core.StringPtr(workerNodeType)
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *AutoscalerOptions) SetDryRun(dryRun bool) *AutoscalerOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *AutoscalerOptions) SetAuthInstanceID(authInstanceID string) *AutoscalerOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *AutoscalerOptions) SetHeaders(param map[string]string) *AutoscalerOptions {
+	options.Headers = param
+	return options
+}
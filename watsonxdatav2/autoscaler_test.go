@@ -0,0 +1,250 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 autoscaler`, func() {
+	var testServer *httptest.Server
+	var workers int64
+	var prestoWorker string
+	var scaleBodies []map[string]interface{}
+	BeforeEach(func() {
+		workers = 2
+		prestoWorker = `"worker": {"node_type": "bx2.16x64", "quantity": %d}`
+		scaleBodies = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.Method + " " + req.URL.EscapedPath() {
+			case "GET /spark_engines/spark01":
+				fmt.Fprintf(res, `{"engine_id": "spark01", "engine_details": {"scale_config": {"current_number_of_nodes": %d}}}`, workers)
+			case "GET /presto_engines/presto01":
+				fmt.Fprintf(res, `{"engine_id": "presto01", "external_host_name": "h", "status_code": 200, `+prestoWorker+`}`, workers)
+			case "GET /spark_engines/spark01/applications":
+				fmt.Fprint(res, `{"applications": [{"state": "ACCEPTED"}, {"state": "running"}, {"state": "waiting"}, {"state": "finished"}]}`)
+			case "POST /spark_engines/spark01/scale", "POST /presto_engines/presto01/scale":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				scaleBodies = append(scaleBodies, body)
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`SparkApplicationsMetricsSource`, func() {
+		It(`Count pending applications`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			load, err := watsonxDataService.NewSparkApplicationsMetricsSource("spark01").Load(context.Background())
+			Expect(err).To(BeNil())
+			Expect(load).To(Equal(float64(2)))
+
+			load, err = watsonxDataService.NewSparkApplicationsMetricsSource("spark01", "running", "finished").Load(context.Background())
+			Expect(err).To(BeNil())
+			Expect(load).To(Equal(float64(2)))
+		})
+	})
+	Describe(`Autoscaler`, func() {
+		It(`Scale within bounds with hysteresis and cooldowns`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			autoscaler, err := watsonxDataService.NewAutoscaler(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(autoscaler).To(BeNil())
+
+			var load float64
+			metrics := watsonxdatav2.AutoscalerMetricsSourceFunc(func(ctx context.Context) (float64, error) {
+				return load, nil
+			})
+			autoscalerOptionsModel := watsonxDataService.NewAutoscalerOptions("spark", "spark01", metrics, 1, 5, 10)
+			autoscalerOptionsModel.SetTolerance(0.1)
+			autoscalerOptionsModel.SetScaleUpCooldown(5 * time.Minute)
+			autoscalerOptionsModel.SetScaleDownCooldown(10 * time.Minute)
+			autoscaler, err = watsonxDataService.NewAutoscaler(autoscalerOptionsModel)
+			Expect(err).To(BeNil())
+			start := time.Date(2025, 6, 6, 12, 0, 0, 0, time.UTC)
+
+			// 21 over 2 nodes is within 10% of the target
+			load = 21
+			decision, err := autoscaler.Evaluate(context.Background(), start)
+			Expect(err).To(BeNil())
+			Expect(decision.Action).To(Equal(watsonxdatav2.AutoscalerDecision_Action_None))
+			Expect(decision.Reason).To(Equal("load within tolerance"))
+
+			// 100 needs 10 nodes, capped at 5
+			load = 100
+			decision, err = autoscaler.Evaluate(context.Background(), start)
+			Expect(err).To(BeNil())
+			Expect(decision.Action).To(Equal(watsonxdatav2.AutoscalerDecision_Action_ScaleUp))
+			Expect(decision.DesiredNodes).To(Equal(int64(5)))
+			Expect(scaleBodies).To(Equal([]map[string]interface{}{{"number_of_nodes": float64(5)}}))
+			workers = 5
+
+			// Scaling down waits for the scale up cooldown
+			load = 10
+			decision, err = autoscaler.Evaluate(context.Background(), start.Add(time.Minute))
+			Expect(err).To(BeNil())
+			Expect(decision.Action).To(Equal(watsonxdatav2.AutoscalerDecision_Action_None))
+			Expect(decision.Reason).To(Equal("in cooldown for another 4m0s"))
+
+			decision, err = autoscaler.Evaluate(context.Background(), start.Add(6*time.Minute))
+			Expect(err).To(BeNil())
+			Expect(decision.Action).To(Equal(watsonxdatav2.AutoscalerDecision_Action_ScaleDown))
+			Expect(decision.DesiredNodes).To(Equal(int64(1)))
+			Expect(scaleBodies).To(HaveLen(2))
+		})
+		It(`Scale Presto workers and handle errors`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			var metricsErr error
+			metrics := watsonxdatav2.AutoscalerMetricsSourceFunc(func(ctx context.Context) (float64, error) {
+				return 35, metricsErr
+			})
+			autoscaler, err := watsonxDataService.NewAutoscaler(watsonxDataService.NewAutoscalerOptions("presto", "presto01", metrics, 1, 8, 10))
+			Expect(err).To(BeNil())
+			decision, err := autoscaler.Evaluate(context.Background(), time.Now())
+			Expect(err).To(BeNil())
+			Expect(decision.DesiredNodes).To(Equal(int64(4)))
+			Expect(scaleBodies).To(Equal([]map[string]interface{}{{
+				"worker": map[string]interface{}{"node_type": "bx2.16x64", "quantity": float64(4)},
+			}}))
+
+			metricsErr = errors.New("queue unavailable")
+			_, err = autoscaler.Evaluate(context.Background(), time.Now())
+			Expect(err).ToNot(BeNil())
+
+			// Without the worker count or node type there is nothing to scale from
+			metricsErr = nil
+			scaleBodies = nil
+			prestoWorker = `"worker": {"node_type": "bx2.16x64"}, "other": %d`
+			_, err = autoscaler.Evaluate(context.Background(), time.Now())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("does not report its worker count"))
+			prestoWorker = `"worker": {"quantity": %d}`
+			_, err = autoscaler.Evaluate(context.Background(), time.Now())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("does not report its worker node type"))
+			Expect(scaleBodies).To(BeEmpty())
+
+			autoscalerOptionsModel := watsonxDataService.NewAutoscalerOptions("presto", "presto01", metrics, 1, 8, 10)
+			autoscalerOptionsModel.SetWorkerNodeType("bx2.32x128")
+			autoscaler, err = watsonxDataService.NewAutoscaler(autoscalerOptionsModel)
+			Expect(err).To(BeNil())
+			_, err = autoscaler.Evaluate(context.Background(), time.Now())
+			Expect(err).To(BeNil())
+			Expect(scaleBodies).To(Equal([]map[string]interface{}{{
+				"worker": map[string]interface{}{"node_type": "bx2.32x128", "quantity": float64(4)},
+			}}))
+
+			_, err = watsonxDataService.NewAutoscaler(watsonxDataService.NewAutoscalerOptions("presto", "presto01", metrics, 3, 2, 10))
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxDataService.NewAutoscaler(watsonxDataService.NewAutoscalerOptions("milvus", "milvus01", metrics, 1, 2, 10))
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxDataService.NewAutoscaler(watsonxDataService.NewAutoscalerOptions("presto", "presto01", nil, 1, 2, 10))
+			Expect(err).ToNot(BeNil())
+			for _, tolerance := range []float64{-0.1, 1} {
+				_, err = watsonxDataService.NewAutoscaler(watsonxDataService.NewAutoscalerOptions("presto", "presto01", metrics, 1, 2, 10).SetTolerance(tolerance))
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("tolerance must be in [0,1)"))
+			}
+		})
+		It(`Reject an invalid load`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			var load float64
+			metrics := watsonxdatav2.AutoscalerMetricsSourceFunc(func(ctx context.Context) (float64, error) {
+				return load, nil
+			})
+			autoscaler, err := watsonxDataService.NewAutoscaler(watsonxDataService.NewAutoscalerOptions("spark", "spark01", metrics, 1, 4, 10))
+			Expect(err).To(BeNil())
+			for _, load = range []float64{-1, math.NaN(), math.Inf(1), math.Inf(-1)} {
+				decision, err := autoscaler.Evaluate(context.Background(), time.Now())
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("the metrics source returned an invalid load"))
+				Expect(decision).To(BeNil())
+			}
+			Expect(scaleBodies).To(BeEmpty())
+		})
+		It(`Only decide in dry-run mode`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			metrics := watsonxdatav2.AutoscalerMetricsSourceFunc(func(ctx context.Context) (float64, error) {
+				return 0, nil
+			})
+			autoscalerOptionsModel := watsonxDataService.NewAutoscalerOptions("spark", "spark01", metrics, 1, 4, 10)
+			autoscalerOptionsModel.SetDryRun(true)
+			autoscalerOptionsModel.SetScaleDownCooldown(time.Hour)
+			autoscaler, err := watsonxDataService.NewAutoscaler(autoscalerOptionsModel)
+			Expect(err).To(BeNil())
+			decision, err := autoscaler.Evaluate(context.Background(), time.Now())
+			Expect(err).To(BeNil())
+			Expect(decision.Action).To(Equal(watsonxdatav2.AutoscalerDecision_Action_ScaleDown))
+			Expect(scaleBodies).To(BeEmpty())
+
+			// Nothing was scaled, so the next evaluation is not held by the cooldown
+			decision, err = autoscaler.Evaluate(context.Background(), time.Now())
+			Expect(err).To(BeNil())
+			Expect(decision.Action).To(Equal(watsonxdatav2.AutoscalerDecision_Action_ScaleDown))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			Expect(autoscaler.Run(ctx, 10*time.Millisecond, nil)).To(Equal(context.DeadlineExceeded))
+		})
+	})
+})
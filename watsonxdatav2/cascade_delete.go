@@ -0,0 +1,398 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// CascadeDeleteStep : A single service call of a cascading delete.
+type CascadeDeleteStep struct {
+	// Name of the SDK operation, for example "DeletePrestoEngineCatalogs".
+	Operation string
+
+	// Parameters of the call, in the order they appear in the operation's path and query.
+	Parameters [][2]string
+
+	run func(ctx context.Context) error
+}
+
+// String returns the call in the form Operation(name=value, ...).
+func (step CascadeDeleteStep) String() string {
+	params := make([]string, len(step.Parameters))
+	for i, param := range step.Parameters {
+		params[i] = param[0] + "=" + param[1]
+	}
+	return step.Operation + "(" + strings.Join(params, ", ") + ")"
+}
+
+// CascadeDeletePlan : The ordered calls that delete a resource and detach everything that depends on it.
+type CascadeDeletePlan struct {
+	// The resource being deleted.
+	Target *DependencyNode
+
+	// Calls to make, in order. The last step deletes the target.
+	Steps []CascadeDeleteStep
+}
+
+// String returns the numbered call sequence, one call per line.
+func (plan *CascadeDeletePlan) String() string {
+	builder := new(strings.Builder)
+	for i, step := range plan.Steps {
+		fmt.Fprintf(builder, "%d. %s\n", i+1, step)
+	}
+	return builder.String()
+}
+
+// CascadeDeleteResult : Outcome of a cascading delete.
+type CascadeDeleteResult struct {
+	// The planned call sequence.
+	Plan *CascadeDeletePlan
+
+	// Number of steps that completed. Always zero in dry-run mode.
+	Completed int
+
+	// True if the plan was only printed.
+	DryRun bool
+}
+
+// DeleteBucketRegistrationCascade : Delete a bucket registration and detach its catalog
+// Detach the catalog of the bucket from every Presto, Prestissimo and Spark engine it is associated with, then
+// delete the bucket registration.
+func (watsonxData *WatsonxDataV2) DeleteBucketRegistrationCascade(deleteCascadeOptions *DeleteCascadeOptions) (result *CascadeDeleteResult, err error) {
+	result, err = watsonxData.DeleteBucketRegistrationCascadeWithContext(context.Background(), deleteCascadeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteBucketRegistrationCascadeWithContext is an alternate form of the DeleteBucketRegistrationCascade method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) DeleteBucketRegistrationCascadeWithContext(ctx context.Context, deleteCascadeOptions *DeleteCascadeOptions) (result *CascadeDeleteResult, err error) {
+	return watsonxData.deleteCascade(ctx, deleteCascadeOptions, []string{DependencyNode_Kind_Bucket})
+}
+
+// DeleteDatabaseCatalogCascade : Delete a database registration and detach its catalog
+// Detach the catalog of the database from every Presto, Prestissimo and Spark engine it is associated with, then
+// delete the database registration.
+func (watsonxData *WatsonxDataV2) DeleteDatabaseCatalogCascade(deleteCascadeOptions *DeleteCascadeOptions) (result *CascadeDeleteResult, err error) {
+	result, err = watsonxData.DeleteDatabaseCatalogCascadeWithContext(context.Background(), deleteCascadeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteDatabaseCatalogCascadeWithContext is an alternate form of the DeleteDatabaseCatalogCascade method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) DeleteDatabaseCatalogCascadeWithContext(ctx context.Context, deleteCascadeOptions *DeleteCascadeOptions) (result *CascadeDeleteResult, err error) {
+	return watsonxData.deleteCascade(ctx, deleteCascadeOptions, []string{DependencyNode_Kind_Database})
+}
+
+// DeleteEngineCascade : Delete an engine and its associations
+// Detach every catalog from the Presto, Prestissimo or Spark engine, disassociate it from its drivers, then delete
+// the engine. The engine type is looked up from the engine ID.
+func (watsonxData *WatsonxDataV2) DeleteEngineCascade(deleteCascadeOptions *DeleteCascadeOptions) (result *CascadeDeleteResult, err error) {
+	result, err = watsonxData.DeleteEngineCascadeWithContext(context.Background(), deleteCascadeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteEngineCascadeWithContext is an alternate form of the DeleteEngineCascade method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) DeleteEngineCascadeWithContext(ctx context.Context, deleteCascadeOptions *DeleteCascadeOptions) (result *CascadeDeleteResult, err error) {
+	return watsonxData.deleteCascade(ctx, deleteCascadeOptions, []string{
+		DependencyNode_Kind_PrestoEngine,
+		DependencyNode_Kind_PrestissimoEngine,
+		DependencyNode_Kind_SparkEngine,
+	})
+}
+
+// deleteCascade plans the delete of the resource, which must be of one of the given kinds, and runs or prints the plan.
+func (watsonxData *WatsonxDataV2) deleteCascade(ctx context.Context, deleteCascadeOptions *DeleteCascadeOptions, kinds []string) (result *CascadeDeleteResult, err error) {
+	err = core.ValidateNotNil(deleteCascadeOptions, "deleteCascadeOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(deleteCascadeOptions, "deleteCascadeOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := deleteCascadeOptions
+
+	graph, err := watsonxData.BuildDependencyGraphWithContext(ctx, &BuildDependencyGraphOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "dependency-graph-error")
+		return
+	}
+
+	var target *DependencyNode
+	for _, kind := range kinds {
+		if target = graph.Node(DependencyNodeID(kind, *opts.ResourceID)); target != nil {
+			break
+		}
+	}
+	if target == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("%s '%s' not found", strings.Join(kinds, " or "), *opts.ResourceID), "resource-not-found", common.GetComponentInfo())
+		return
+	}
+
+	plan := watsonxData.planCascadeDelete(graph, target, opts)
+	result = &CascadeDeleteResult{
+		Plan:   plan,
+		DryRun: opts.DryRun != nil && *opts.DryRun,
+	}
+	for _, step := range plan.Steps {
+		if opts.Output != nil {
+			prefix := ""
+			if result.DryRun {
+				prefix = "[dry-run] "
+			}
+			fmt.Fprintf(opts.Output, "%s%s\n", prefix, step)
+		}
+		if result.DryRun {
+			continue
+		}
+		if err = step.run(ctx); err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("cascading delete of %s stopped at step %d: %s", target.ID, result.Completed+1, step), "cascade-step-error", common.GetComponentInfo())
+			return
+		}
+		result.Completed++
+	}
+	return
+}
+
+// planCascadeDelete returns the calls that detach the dependents of target and then delete it.
+func (watsonxData *WatsonxDataV2) planCascadeDelete(graph *DependencyGraph, target *DependencyNode, opts *DeleteCascadeOptions) (plan *CascadeDeletePlan) {
+	plan = &CascadeDeletePlan{Target: target}
+	switch target.Kind {
+	case DependencyNode_Kind_Bucket, DependencyNode_Kind_Database:
+		for _, catalog := range graph.DirectDependents(target.ID) {
+			if catalog.Kind != DependencyNode_Kind_Catalog {
+				continue
+			}
+			for _, engine := range graph.DirectDependents(catalog.ID) {
+				if step, ok := watsonxData.detachCatalogsStep(engine, []string{catalog.ResourceID}, opts); ok {
+					plan.Steps = append(plan.Steps, step)
+				}
+			}
+		}
+	default:
+		var catalogs []string
+		for _, edge := range graph.Edges() {
+			if edge.From == target.ID && edge.Relation == DependencyEdge_Relation_UsesCatalog {
+				catalogs = append(catalogs, graph.Node(edge.To).ResourceID)
+			}
+		}
+		if step, ok := watsonxData.detachCatalogsStep(target, catalogs, opts); ok && len(catalogs) > 0 {
+			plan.Steps = append(plan.Steps, step)
+		}
+		for _, edge := range graph.Edges() {
+			if edge.From == target.ID && edge.Relation == DependencyEdge_Relation_UsesDriver {
+				plan.Steps = append(plan.Steps, watsonxData.detachDriverStep(graph.Node(edge.To), target, opts))
+			}
+		}
+	}
+	plan.Steps = append(plan.Steps, watsonxData.deleteTargetStep(target, opts))
+	return
+}
+
+// detachCatalogsStep returns the call that removes the catalogs from the engine, if the engine type supports it.
+func (watsonxData *WatsonxDataV2) detachCatalogsStep(engine *DependencyNode, catalogs []string, opts *DeleteCascadeOptions) (step CascadeDeleteStep, ok bool) {
+	engineID := core.StringPtr(engine.ResourceID)
+	catalogNames := core.StringPtr(strings.Join(catalogs, ","))
+	step.Parameters = [][2]string{{"engine_id", engine.ResourceID}, {"catalog_names", *catalogNames}}
+	ok = true
+	switch engine.Kind {
+	case DependencyNode_Kind_PrestoEngine:
+		step.Operation = "DeletePrestoEngineCatalogs"
+		step.run = func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeletePrestoEngineCatalogsWithContext(ctx, &DeletePrestoEngineCatalogsOptions{
+				EngineID:       engineID,
+				CatalogNames:   catalogNames,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		}
+	case DependencyNode_Kind_PrestissimoEngine:
+		step.Operation = "DeletePrestissimoEngineCatalogs"
+		step.run = func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeletePrestissimoEngineCatalogsWithContext(ctx, &DeletePrestissimoEngineCatalogsOptions{
+				EngineID:       engineID,
+				CatalogNames:   catalogNames,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		}
+	case DependencyNode_Kind_SparkEngine:
+		step.Operation = "DeleteSparkEngineCatalogs"
+		step.run = func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeleteSparkEngineCatalogsWithContext(ctx, &DeleteSparkEngineCatalogsOptions{
+				EngineID:       engineID,
+				CatalogNames:   catalogNames,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		}
+	default:
+		ok = false
+	}
+	return
+}
+
+// detachDriverStep returns the call that disassociates the engine from the driver.
+func (watsonxData *WatsonxDataV2) detachDriverStep(driver *DependencyNode, engine *DependencyNode, opts *DeleteCascadeOptions) CascadeDeleteStep {
+	return CascadeDeleteStep{
+		Operation:  "DeleteDriverEngines",
+		Parameters: [][2]string{{"driver_id", driver.ResourceID}, {"engine_ids", engine.ResourceID}},
+		run: func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeleteDriverEnginesWithContext(ctx, &DeleteDriverEnginesOptions{
+				DriverID:       core.StringPtr(driver.ResourceID),
+				EngineIds:      core.StringPtr(engine.ResourceID),
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		},
+	}
+}
+
+// deleteTargetStep returns the call that deletes the target itself.
+func (watsonxData *WatsonxDataV2) deleteTargetStep(target *DependencyNode, opts *DeleteCascadeOptions) (step CascadeDeleteStep) {
+	id := core.StringPtr(target.ResourceID)
+	switch target.Kind {
+	case DependencyNode_Kind_Bucket:
+		step.Operation = "DeleteBucketRegistration"
+		step.Parameters = [][2]string{{"bucket_id", target.ResourceID}}
+		step.run = func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeleteBucketRegistrationWithContext(ctx, &DeleteBucketRegistrationOptions{
+				BucketID:       id,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		}
+	case DependencyNode_Kind_Database:
+		step.Operation = "DeleteDatabaseCatalog"
+		step.Parameters = [][2]string{{"database_id", target.ResourceID}}
+		step.run = func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeleteDatabaseCatalogWithContext(ctx, &DeleteDatabaseCatalogOptions{
+				DatabaseID:     id,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		}
+	case DependencyNode_Kind_PrestoEngine:
+		step.Operation = "DeleteEngine"
+		step.Parameters = [][2]string{{"engine_id", target.ResourceID}}
+		step.run = func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeleteEngineWithContext(ctx, &DeleteEngineOptions{
+				EngineID:       id,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		}
+	case DependencyNode_Kind_PrestissimoEngine:
+		step.Operation = "DeletePrestissimoEngine"
+		step.Parameters = [][2]string{{"engine_id", target.ResourceID}}
+		step.run = func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeletePrestissimoEngineWithContext(ctx, &DeletePrestissimoEngineOptions{
+				EngineID:       id,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		}
+	case DependencyNode_Kind_SparkEngine:
+		step.Operation = "DeleteSparkEngine"
+		step.Parameters = [][2]string{{"engine_id", target.ResourceID}}
+		step.run = func(ctx context.Context) (err error) {
+			_, err = watsonxData.DeleteSparkEngineWithContext(ctx, &DeleteSparkEngineOptions{
+				EngineID:       id,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+			return
+		}
+	}
+	return
+}
+
+// DeleteCascadeOptions : The DeleteBucketRegistrationCascade, DeleteDatabaseCatalogCascade and DeleteEngineCascade options.
+type DeleteCascadeOptions struct {
+	// Bucket, database or engine ID.
+	ResourceID *string `json:"resource_id" validate:"required,ne="`
+
+	// Only print the call sequence, without making any of the calls.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// If set, each call is written to Output before it is made, or instead of it in dry-run mode.
+	Output io.Writer
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewDeleteCascadeOptions : Instantiate DeleteCascadeOptions
+func (*WatsonxDataV2) NewDeleteCascadeOptions(resourceID string) *DeleteCascadeOptions {
+	return &DeleteCascadeOptions{
+		ResourceID: core.StringPtr(resourceID),
+	}
+}
+
+// SetResourceID : Allow user to set ResourceID
+func (_options *DeleteCascadeOptions) SetResourceID(resourceID string) *DeleteCascadeOptions {
+	_options.ResourceID = core.StringPtr(resourceID)
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *DeleteCascadeOptions) SetDryRun(dryRun bool) *DeleteCascadeOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetOutput : Allow user to set Output
+func (_options *DeleteCascadeOptions) SetOutput(output io.Writer) *DeleteCascadeOptions {
+	_options.Output = output
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *DeleteCascadeOptions) SetAuthInstanceID(authInstanceID string) *DeleteCascadeOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *DeleteCascadeOptions) SetHeaders(param map[string]string) *DeleteCascadeOptions {
+	options.Headers = param
+	return options
+}
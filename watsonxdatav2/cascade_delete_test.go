@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 cascading delete`, func() {
+	var testServer *httptest.Server
+	var deletes []string
+	var failPath string
+	BeforeEach(func() {
+		deletes = nil
+		failPath = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == "DELETE" {
+				call := req.URL.EscapedPath()
+				if req.URL.RawQuery != "" {
+					call += "?" + req.URL.RawQuery
+				}
+				deletes = append(deletes, call)
+				if req.URL.EscapedPath() == failPath {
+					res.WriteHeader(409)
+					fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "in use"}]}`)
+					return
+				}
+				res.WriteHeader(204)
+				return
+			}
+			body, ok := dependencyGraphResponses[req.URL.EscapedPath()]
+			Expect(ok).To(BeTrue(), req.URL.EscapedPath())
+			res.WriteHeader(200)
+			fmt.Fprint(res, body)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`DeleteBucketRegistrationCascade(deleteCascadeOptions *DeleteCascadeOptions)`, func() {
+		It(`Print the call sequence in dry-run mode`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			result, operationErr := watsonxDataService.DeleteBucketRegistrationCascade(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			output := new(bytes.Buffer)
+			deleteCascadeOptionsModel := watsonxDataService.NewDeleteCascadeOptions("bucket-1")
+			deleteCascadeOptionsModel.SetDryRun(true)
+			deleteCascadeOptionsModel.SetOutput(output)
+			result, operationErr = watsonxDataService.DeleteBucketRegistrationCascade(deleteCascadeOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.DryRun).To(BeTrue())
+			Expect(result.Completed).To(Equal(0))
+			Expect(deletes).To(BeEmpty())
+			Expect(result.Plan.String()).To(Equal("" +
+				"1. DeletePrestoEngineCatalogs(engine_id=presto01, catalog_names=iceberg_data)\n" +
+				"2. DeleteSparkEngineCatalogs(engine_id=spark01, catalog_names=iceberg_data)\n" +
+				"3. DeleteBucketRegistration(bucket_id=bucket-1)\n"))
+			Expect(output.String()).To(Equal("" +
+				"[dry-run] DeletePrestoEngineCatalogs(engine_id=presto01, catalog_names=iceberg_data)\n" +
+				"[dry-run] DeleteSparkEngineCatalogs(engine_id=spark01, catalog_names=iceberg_data)\n" +
+				"[dry-run] DeleteBucketRegistration(bucket_id=bucket-1)\n"))
+		})
+		It(`Detach the catalog and delete the bucket`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, operationErr := watsonxDataService.DeleteBucketRegistrationCascade(watsonxDataService.NewDeleteCascadeOptions("bucket-1"))
+			Expect(operationErr).To(BeNil())
+			Expect(result.Completed).To(Equal(3))
+			Expect(deletes).To(Equal([]string{
+				"/presto_engines/presto01/catalogs?catalog_names=iceberg_data",
+				"/spark_engines/spark01/catalogs?catalog_names=iceberg_data",
+				"/bucket_registrations/bucket-1",
+			}))
+		})
+		It(`Stop at the first failing call`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			failPath = "/spark_engines/spark01/catalogs"
+			result, operationErr := watsonxDataService.DeleteBucketRegistrationCascade(watsonxDataService.NewDeleteCascadeOptions("bucket-1"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("stopped at step 2"))
+			Expect(result.Completed).To(Equal(1))
+			Expect(deletes).To(HaveLen(2))
+		})
+		It(`Return an error for an unknown bucket`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, operationErr := watsonxDataService.DeleteBucketRegistrationCascade(watsonxDataService.NewDeleteCascadeOptions("db-1"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+	Describe(`DeleteDatabaseCatalogCascade(deleteCascadeOptions *DeleteCascadeOptions)`, func() {
+		It(`Detach the catalog and delete the database`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, operationErr := watsonxDataService.DeleteDatabaseCatalogCascade(watsonxDataService.NewDeleteCascadeOptions("db-1"))
+			Expect(operationErr).To(BeNil())
+			Expect(result.Completed).To(Equal(2))
+			Expect(deletes).To(Equal([]string{
+				"/presto_engines/presto01/catalogs?catalog_names=pg_catalog",
+				"/database_registrations/db-1",
+			}))
+		})
+	})
+	Describe(`DeleteEngineCascade(deleteCascadeOptions *DeleteCascadeOptions)`, func() {
+		It(`Detach catalogs and drivers and delete the engine`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			deleteCascadeOptionsModel := new(watsonxdatav2.DeleteCascadeOptions)
+			deleteCascadeOptionsModel.SetResourceID("presto01")
+			result, operationErr := watsonxDataService.DeleteEngineCascade(deleteCascadeOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.Plan.Target.Kind).To(Equal(watsonxdatav2.DependencyNode_Kind_PrestoEngine))
+			Expect(deletes).To(Equal([]string{
+				"/presto_engines/presto01/catalogs?catalog_names=iceberg_data%2Cpg_catalog",
+				"/driver_registrations/driver-1/engines?engine_ids=presto01",
+				"/presto_engines/presto01",
+			}))
+		})
+		It(`Delete a Prestissimo engine`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			result, operationErr := watsonxDataService.DeleteEngineCascade(watsonxDataService.NewDeleteCascadeOptions("prestissimo01"))
+			Expect(operationErr).To(BeNil())
+			Expect(result.Completed).To(Equal(2))
+			Expect(deletes).To(Equal([]string{
+				"/prestissimo_engines/prestissimo01/catalogs?catalog_names=hive_data",
+				"/prestissimo_engines/prestissimo01",
+			}))
+		})
+	})
+})
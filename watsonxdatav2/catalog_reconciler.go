@@ -0,0 +1,382 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// EngineCatalogAssociation : The catalogs an engine should be associated with.
+type EngineCatalogAssociation struct {
+	// Engine type.
+	EngineType *string `json:"engine_type" validate:"required"`
+
+	// Engine ID.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Catalog names. An empty list detaches every catalog from the engine.
+	Catalogs []string `json:"catalogs"`
+}
+
+// Constants associated with the EngineCatalogAssociation.EngineType property.
+// Engine type.
+const (
+	EngineCatalogAssociation_EngineType_Prestissimo = "prestissimo"
+	EngineCatalogAssociation_EngineType_Presto      = "presto"
+	EngineCatalogAssociation_EngineType_Spark       = "spark"
+)
+
+// NewEngineCatalogAssociation : Instantiate EngineCatalogAssociation (Generic Model Constructor)
+func (*WatsonxDataV2) NewEngineCatalogAssociation(engineType string, engineID string, catalogs []string) (_model *EngineCatalogAssociation, err error) {
+	_model = &EngineCatalogAssociation{
+		EngineType: core.StringPtr(engineType),
+		EngineID:   core.StringPtr(engineID),
+		Catalogs:   catalogs,
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// EngineCatalogChange : The catalog changes made, or planned, for one engine.
+type EngineCatalogChange struct {
+	// Engine type.
+	EngineType string
+
+	// Engine ID.
+	EngineID string
+
+	// Catalogs to associate with the engine.
+	Added []string
+
+	// Catalogs to detach from the engine.
+	Removed []string
+
+	// True if the engine was restarted after the change.
+	Restarted bool
+
+	// Error that stopped the reconciliation of this engine, if any.
+	Err error
+}
+
+// Changed returns true if catalogs are added to or removed from the engine.
+func (change *EngineCatalogChange) Changed() bool {
+	return len(change.Added) > 0 || len(change.Removed) > 0
+}
+
+// String returns a one line summary of the change.
+func (change *EngineCatalogChange) String() string {
+	if !change.Changed() {
+		return fmt.Sprintf("%s engine %s: in sync", change.EngineType, change.EngineID)
+	}
+	var parts []string
+	if len(change.Added) > 0 {
+		parts = append(parts, "+"+strings.Join(change.Added, ",+"))
+	}
+	if len(change.Removed) > 0 {
+		parts = append(parts, "-"+strings.Join(change.Removed, ",-"))
+	}
+	return fmt.Sprintf("%s engine %s: %s", change.EngineType, change.EngineID, strings.Join(parts, " "))
+}
+
+// EngineCatalogReconciliation : Outcome of reconciling engine catalog associations.
+type EngineCatalogReconciliation struct {
+	// One entry per engine, in the order the engines were declared.
+	Changes []EngineCatalogChange
+
+	// True if the changes were only computed.
+	DryRun bool
+}
+
+// Failed returns true if the reconciliation of any engine failed.
+func (reconciliation *EngineCatalogReconciliation) Failed() bool {
+	for _, change := range reconciliation.Changes {
+		if change.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ReconcileEngineCatalogs : Bring engine catalog associations to the declared state
+// Compare the declared catalogs of each engine with the catalogs currently associated with it and issue the minimal
+// create and delete calls to make them match, optionally restarting Presto and Prestissimo engines that changed.
+// A failure for one engine is recorded in its change and does not stop the other engines from being reconciled.
+func (watsonxData *WatsonxDataV2) ReconcileEngineCatalogs(reconcileEngineCatalogsOptions *ReconcileEngineCatalogsOptions) (result *EngineCatalogReconciliation, err error) {
+	result, err = watsonxData.ReconcileEngineCatalogsWithContext(context.Background(), reconcileEngineCatalogsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReconcileEngineCatalogsWithContext is an alternate form of the ReconcileEngineCatalogs method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) ReconcileEngineCatalogsWithContext(ctx context.Context, reconcileEngineCatalogsOptions *ReconcileEngineCatalogsOptions) (result *EngineCatalogReconciliation, err error) {
+	err = core.ValidateNotNil(reconcileEngineCatalogsOptions, "reconcileEngineCatalogsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(reconcileEngineCatalogsOptions, "reconcileEngineCatalogsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := reconcileEngineCatalogsOptions
+	for _, engine := range opts.Engines {
+		switch *engine.EngineType {
+		case EngineCatalogAssociation_EngineType_Presto, EngineCatalogAssociation_EngineType_Prestissimo, EngineCatalogAssociation_EngineType_Spark:
+		default:
+			err = core.SDKErrorf(nil, fmt.Sprintf("unsupported engine type '%s' for engine '%s'", *engine.EngineType, *engine.EngineID), "invalid-engine-type", common.GetComponentInfo())
+			return
+		}
+	}
+
+	result = &EngineCatalogReconciliation{
+		DryRun: opts.DryRun != nil && *opts.DryRun,
+	}
+	for _, engine := range opts.Engines {
+		change := EngineCatalogChange{
+			EngineType: *engine.EngineType,
+			EngineID:   *engine.EngineID,
+		}
+		change.Err = watsonxData.reconcileEngine(ctx, opts, engine, &change, result.DryRun)
+		result.Changes = append(result.Changes, change)
+	}
+	return
+}
+
+// reconcileEngine computes the change for one engine and, unless dryRun is set, applies it.
+func (watsonxData *WatsonxDataV2) reconcileEngine(ctx context.Context, opts *ReconcileEngineCatalogsOptions, engine EngineCatalogAssociation, change *EngineCatalogChange, dryRun bool) (err error) {
+	current, err := watsonxData.listEngineCatalogNames(ctx, opts, *engine.EngineType, engine.EngineID)
+	if err != nil {
+		return
+	}
+	change.Added, change.Removed = diffCatalogNames(current, engine.Catalogs)
+	if dryRun || !change.Changed() {
+		return
+	}
+
+	if len(change.Removed) > 0 {
+		catalogNames := core.StringPtr(strings.Join(change.Removed, ","))
+		switch *engine.EngineType {
+		case EngineCatalogAssociation_EngineType_Presto:
+			_, err = watsonxData.DeletePrestoEngineCatalogsWithContext(ctx, &DeletePrestoEngineCatalogsOptions{
+				EngineID:       engine.EngineID,
+				CatalogNames:   catalogNames,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+		case EngineCatalogAssociation_EngineType_Prestissimo:
+			_, err = watsonxData.DeletePrestissimoEngineCatalogsWithContext(ctx, &DeletePrestissimoEngineCatalogsOptions{
+				EngineID:       engine.EngineID,
+				CatalogNames:   catalogNames,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+		case EngineCatalogAssociation_EngineType_Spark:
+			_, err = watsonxData.DeleteSparkEngineCatalogsWithContext(ctx, &DeleteSparkEngineCatalogsOptions{
+				EngineID:       engine.EngineID,
+				CatalogNames:   catalogNames,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+		}
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "delete-engine-catalogs-error")
+			return
+		}
+	}
+
+	if len(change.Added) > 0 {
+		catalogName := core.StringPtr(strings.Join(change.Added, ","))
+		switch *engine.EngineType {
+		case EngineCatalogAssociation_EngineType_Presto:
+			_, _, err = watsonxData.CreatePrestoEngineCatalogsWithContext(ctx, &CreatePrestoEngineCatalogsOptions{
+				EngineID:       engine.EngineID,
+				CatalogName:    catalogName,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+		case EngineCatalogAssociation_EngineType_Prestissimo:
+			_, _, err = watsonxData.CreatePrestissimoEngineCatalogsWithContext(ctx, &CreatePrestissimoEngineCatalogsOptions{
+				EngineID:       engine.EngineID,
+				CatalogName:    catalogName,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+		case EngineCatalogAssociation_EngineType_Spark:
+			_, _, err = watsonxData.CreateSparkEngineCatalogsWithContext(ctx, &CreateSparkEngineCatalogsOptions{
+				EngineID:       engine.EngineID,
+				CatalogName:    catalogName,
+				AuthInstanceID: opts.AuthInstanceID,
+				Headers:        opts.Headers,
+			})
+		}
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "create-engine-catalogs-error")
+			return
+		}
+	}
+
+	if opts.Restart == nil || !*opts.Restart {
+		return
+	}
+	// Spark engines pick up catalog changes without a restart and have no restart operation.
+	switch *engine.EngineType {
+	case EngineCatalogAssociation_EngineType_Presto:
+		_, _, err = watsonxData.RestartPrestoEngineWithContext(ctx, &RestartPrestoEngineOptions{
+			EngineID:       engine.EngineID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+		change.Restarted = err == nil
+	case EngineCatalogAssociation_EngineType_Prestissimo:
+		_, _, err = watsonxData.RestartPrestissimoEngineWithContext(ctx, &RestartPrestissimoEngineOptions{
+			EngineID:       engine.EngineID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+		change.Restarted = err == nil
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "restart-engine-error")
+	}
+	return
+}
+
+// listEngineCatalogNames returns the names of the catalogs currently associated with the engine.
+func (watsonxData *WatsonxDataV2) listEngineCatalogNames(ctx context.Context, opts *ReconcileEngineCatalogsOptions, engineType string, engineID *string) (names []string, err error) {
+	var collection *CatalogCollection
+	switch engineType {
+	case EngineCatalogAssociation_EngineType_Presto:
+		collection, _, err = watsonxData.ListPrestoEngineCatalogsWithContext(ctx, &ListPrestoEngineCatalogsOptions{
+			EngineID:       engineID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+	case EngineCatalogAssociation_EngineType_Prestissimo:
+		collection, _, err = watsonxData.ListPrestissimoEngineCatalogsWithContext(ctx, &ListPrestissimoEngineCatalogsOptions{
+			EngineID:       engineID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+	case EngineCatalogAssociation_EngineType_Spark:
+		collection, _, err = watsonxData.ListSparkEngineCatalogsWithContext(ctx, &ListSparkEngineCatalogsOptions{
+			EngineID:       engineID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-engine-catalogs-error")
+		return
+	}
+	for _, catalog := range collection.Catalogs {
+		if catalog.CatalogName != nil {
+			names = append(names, *catalog.CatalogName)
+		}
+	}
+	return
+}
+
+// diffCatalogNames returns the sorted names in desired but not in current, and in current but not in desired.
+func diffCatalogNames(current []string, desired []string) (added []string, removed []string) {
+	currentSet := map[string]bool{}
+	for _, name := range current {
+		currentSet[name] = true
+	}
+	desiredSet := map[string]bool{}
+	for _, name := range desired {
+		if desiredSet[name] {
+			continue
+		}
+		desiredSet[name] = true
+		if !currentSet[name] {
+			added = append(added, name)
+		}
+	}
+	for name := range currentSet {
+		if !desiredSet[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return
+}
+
+// ReconcileEngineCatalogsOptions : The ReconcileEngineCatalogs options.
+type ReconcileEngineCatalogsOptions struct {
+	// Declared catalog associations, one entry per engine.
+	Engines []EngineCatalogAssociation `json:"engines" validate:"required,min=1,dive"`
+
+	// Restart Presto and Prestissimo engines whose catalogs changed.
+	Restart *bool `json:"restart,omitempty"`
+
+	// Only compute the changes, without making them.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewReconcileEngineCatalogsOptions : Instantiate ReconcileEngineCatalogsOptions
+func (*WatsonxDataV2) NewReconcileEngineCatalogsOptions(engines []EngineCatalogAssociation) *ReconcileEngineCatalogsOptions {
+	return &ReconcileEngineCatalogsOptions{
+		Engines: engines,
+	}
+}
+
+// SetEngines : Allow user to set Engines
+func (_options *ReconcileEngineCatalogsOptions) SetEngines(engines []EngineCatalogAssociation) *ReconcileEngineCatalogsOptions {
+	_options.Engines = engines
+	return _options
+}
+
+// SetRestart : Allow user to set Restart
+func (_options *ReconcileEngineCatalogsOptions) SetRestart(restart bool) *ReconcileEngineCatalogsOptions {
+	_options.Restart = core.BoolPtr(restart)
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *ReconcileEngineCatalogsOptions) SetDryRun(dryRun bool) *ReconcileEngineCatalogsOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *ReconcileEngineCatalogsOptions) SetAuthInstanceID(authInstanceID string) *ReconcileEngineCatalogsOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ReconcileEngineCatalogsOptions) SetHeaders(param map[string]string) *ReconcileEngineCatalogsOptions {
+	options.Headers = param
+	return options
+}
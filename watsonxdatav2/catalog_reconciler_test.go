@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 engine catalog reconciler`, func() {
+	var testServer *httptest.Server
+	var calls []string
+	BeforeEach(func() {
+		calls = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			call := req.Method + " " + req.URL.EscapedPath()
+			if req.URL.RawQuery != "" {
+				call += "?" + req.URL.RawQuery
+			}
+			if req.Method != "GET" {
+				calls = append(calls, call)
+			}
+			switch call {
+			case "GET /presto_engines/presto01/catalogs":
+				fmt.Fprint(res, `{"catalogs": [{"catalog_name": "iceberg_data"}, {"catalog_name": "hive_data"}]}`)
+			case "GET /prestissimo_engines/prestissimo01/catalogs":
+				fmt.Fprint(res, `{"catalogs": [{"catalog_name": "iceberg_data"}]}`)
+			case "GET /spark_engines/spark01/catalogs":
+				fmt.Fprint(res, `{"catalogs": []}`)
+			case "GET /spark_engines/missing/catalogs":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "engine not found"}]}`)
+			case "DELETE /presto_engines/presto01/catalogs?catalog_names=hive_data":
+				res.WriteHeader(204)
+			case "POST /presto_engines/presto01/catalogs", "POST /spark_engines/spark01/catalogs":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{}`)
+			case "POST /presto_engines/presto01/restart":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{}`)
+			default:
+				Fail("unexpected request " + call)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`ReconcileEngineCatalogs(reconcileEngineCatalogsOptions *ReconcileEngineCatalogsOptions)`, func() {
+		It(`Compute the changes in dry-run mode`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			result, operationErr := watsonxDataService.ReconcileEngineCatalogs(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			presto, err := watsonxDataService.NewEngineCatalogAssociation("presto", "presto01", []string{"iceberg_data", "pg_catalog"})
+			Expect(err).To(BeNil())
+			prestissimo, err := watsonxDataService.NewEngineCatalogAssociation("prestissimo", "prestissimo01", []string{"iceberg_data"})
+			Expect(err).To(BeNil())
+			reconcileEngineCatalogsOptionsModel := watsonxDataService.NewReconcileEngineCatalogsOptions([]watsonxdatav2.EngineCatalogAssociation{*presto, *prestissimo})
+			reconcileEngineCatalogsOptionsModel.SetDryRun(true)
+			result, operationErr = watsonxDataService.ReconcileEngineCatalogs(reconcileEngineCatalogsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.DryRun).To(BeTrue())
+			Expect(result.Changes).To(HaveLen(2))
+			Expect(result.Changes[0].Added).To(Equal([]string{"pg_catalog"}))
+			Expect(result.Changes[0].Removed).To(Equal([]string{"hive_data"}))
+			Expect(result.Changes[0].String()).To(Equal("presto engine presto01: +pg_catalog -hive_data"))
+			Expect(result.Changes[1].Changed()).To(BeFalse())
+			Expect(result.Changes[1].String()).To(Equal("prestissimo engine prestissimo01: in sync"))
+			Expect(calls).To(BeEmpty())
+		})
+		It(`Apply the changes and restart changed engines`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			reconcileEngineCatalogsOptionsModel := watsonxDataService.NewReconcileEngineCatalogsOptions([]watsonxdatav2.EngineCatalogAssociation{
+				{EngineType: core.StringPtr("presto"), EngineID: core.StringPtr("presto01"), Catalogs: []string{"iceberg_data", "pg_catalog"}},
+				{EngineType: core.StringPtr("prestissimo"), EngineID: core.StringPtr("prestissimo01"), Catalogs: []string{"iceberg_data"}},
+				{EngineType: core.StringPtr("spark"), EngineID: core.StringPtr("spark01"), Catalogs: []string{"iceberg_data"}},
+				{EngineType: core.StringPtr("spark"), EngineID: core.StringPtr("missing"), Catalogs: []string{"iceberg_data"}},
+			})
+			reconcileEngineCatalogsOptionsModel.SetRestart(true)
+			result, operationErr := watsonxDataService.ReconcileEngineCatalogs(reconcileEngineCatalogsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(calls).To(Equal([]string{
+				"DELETE /presto_engines/presto01/catalogs?catalog_names=hive_data",
+				"POST /presto_engines/presto01/catalogs",
+				"POST /presto_engines/presto01/restart",
+				"POST /spark_engines/spark01/catalogs",
+			}))
+			Expect(result.Changes[0].Restarted).To(BeTrue())
+			Expect(result.Changes[1].Restarted).To(BeFalse())
+			Expect(result.Changes[2].Restarted).To(BeFalse())
+			Expect(result.Changes[3].Err).ToNot(BeNil())
+			Expect(result.Failed()).To(BeTrue())
+		})
+		It(`Reject an unsupported engine type`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			reconcileEngineCatalogsOptionsModel := watsonxDataService.NewReconcileEngineCatalogsOptions([]watsonxdatav2.EngineCatalogAssociation{
+				{EngineType: core.StringPtr("netezza"), EngineID: core.StringPtr("netezza01")},
+			})
+			result, operationErr := watsonxDataService.ReconcileEngineCatalogs(reconcileEngineCatalogsOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			reconcileEngineCatalogsOptionsModel.SetEngines([]watsonxdatav2.EngineCatalogAssociation{{EngineType: core.StringPtr("presto")}})
+			result, operationErr = watsonxDataService.ReconcileEngineCatalogs(reconcileEngineCatalogsOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+})
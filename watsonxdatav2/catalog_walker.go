@@ -0,0 +1,464 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// defaultCatalogWalkConcurrency is the default number of metadata requests in flight.
+const defaultCatalogWalkConcurrency = 4
+
+// SkipCatalogMetadata can be returned by a CatalogMetadataVisitor function to skip the children of the visited
+// catalog, schema or table. It is not returned by WalkCatalogMetadata.
+var SkipCatalogMetadata = errors.New("skip catalog metadata")
+
+// CatalogMetadataPath : The location of a schema, table or column list.
+type CatalogMetadataPath struct {
+	// Catalog name.
+	Catalog string
+
+	// Schema name.
+	Schema string
+
+	// Table name, if the path is a table.
+	Table string
+}
+
+// String returns the path as catalog.schema or catalog.schema.table.
+func (metadataPath CatalogMetadataPath) String() string {
+	parts := []string{metadataPath.Catalog, metadataPath.Schema, metadataPath.Table}
+	for len(parts) > 0 && parts[len(parts)-1] == "" {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, ".")
+}
+
+// CatalogMetadataVisitor : Functions called by WalkCatalogMetadata at each level
+// Functions that are nil are not called, and levels below the last non-nil function are not listed. Functions may be
+// called concurrently. A function returning SkipCatalogMetadata skips the children of the object; any other error
+// stops the walk.
+type CatalogMetadataVisitor struct {
+	// Called for each catalog.
+	Catalog func(ctx context.Context, catalog *Catalog) error
+
+	// Called for each schema.
+	Schema func(ctx context.Context, schema CatalogMetadataPath) error
+
+	// Called for each table.
+	Table func(ctx context.Context, table CatalogMetadataPath) error
+
+	// Called with the columns of each table.
+	Columns func(ctx context.Context, table CatalogMetadataPath, columns []Column) error
+}
+
+// CatalogMetadataWalkSummary : Numbers of objects visited by WalkCatalogMetadata.
+type CatalogMetadataWalkSummary struct {
+	// Catalogs visited.
+	Catalogs int64
+
+	// Schemas visited.
+	Schemas int64
+
+	// Tables visited.
+	Tables int64
+
+	// Columns visited.
+	Columns int64
+}
+
+// catalogWalker holds the state of one WalkCatalogMetadata call.
+type catalogWalker struct {
+	watsonxData *WatsonxDataV2
+	ctx         context.Context
+	cancel      context.CancelFunc
+	opts        *WalkCatalogMetadataOptions
+	include     [][]string
+	exclude     [][]string
+	slots       chan struct{}
+	wg          sync.WaitGroup
+	errOnce     sync.Once
+	err         error
+	summary     CatalogMetadataWalkSummary
+}
+
+// WalkCatalogMetadata : Visit the catalogs, schemas, tables and columns visible to an engine
+// Catalogs come from ListCatalogs; catalogs associated with other engines only are skipped. Schemas, tables and
+// columns come from ListSchemas, ListTables and ListColumns, with at most Concurrency requests in flight.
+//
+// Include and Exclude are lists of glob patterns, as in path.Match, of the form catalog, catalog.schema or
+// catalog.schema.table. An object is walked if it matches the leading parts of an Include pattern, or if Include is
+// empty, and does not match an Exclude pattern with as many or fewer parts. So "sales.*" includes every schema of
+// catalog sales and "*.tmp_*" excludes schemas starting with tmp_ and their tables.
+func (watsonxData *WatsonxDataV2) WalkCatalogMetadata(walkCatalogMetadataOptions *WalkCatalogMetadataOptions) (result *CatalogMetadataWalkSummary, err error) {
+	result, err = watsonxData.WalkCatalogMetadataWithContext(context.Background(), walkCatalogMetadataOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WalkCatalogMetadataWithContext is an alternate form of the WalkCatalogMetadata method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) WalkCatalogMetadataWithContext(ctx context.Context, walkCatalogMetadataOptions *WalkCatalogMetadataOptions) (result *CatalogMetadataWalkSummary, err error) {
+	err = core.ValidateNotNil(walkCatalogMetadataOptions, "walkCatalogMetadataOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(walkCatalogMetadataOptions, "walkCatalogMetadataOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := walkCatalogMetadataOptions
+
+	walker := &catalogWalker{
+		watsonxData: watsonxData,
+		opts:        opts,
+	}
+	if walker.include, err = splitCatalogPatterns(opts.Include); err != nil {
+		return
+	}
+	if walker.exclude, err = splitCatalogPatterns(opts.Exclude); err != nil {
+		return
+	}
+	concurrency := int64(defaultCatalogWalkConcurrency)
+	if opts.Concurrency != nil && *opts.Concurrency > 0 {
+		concurrency = *opts.Concurrency
+	}
+	walker.slots = make(chan struct{}, concurrency)
+	walker.ctx, walker.cancel = context.WithCancel(ctx)
+	defer walker.cancel()
+
+	var catalogs *CatalogCollection
+	err = walker.call(func() (err error) {
+		catalogs, _, err = watsonxData.ListCatalogsWithContext(walker.ctx, &ListCatalogsOptions{
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+		return
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-catalogs-error")
+		return
+	}
+	for i := range catalogs.Catalogs {
+		catalog := &catalogs.Catalogs[i]
+		if catalog.CatalogName == nil || !walker.walks(*catalog.CatalogName) {
+			continue
+		}
+		if len(catalog.AssociatedEngines) > 0 && !containsFold(catalog.AssociatedEngines, *opts.EngineID) {
+			continue
+		}
+		walker.spawn(func() error {
+			return walker.walkCatalog(catalog)
+		})
+	}
+	walker.wg.Wait()
+	if walker.err != nil {
+		err = walker.err
+		return
+	}
+	result = &walker.summary
+	return
+}
+
+// splitCatalogPatterns splits catalog.schema.table patterns into their parts and checks them.
+func splitCatalogPatterns(patterns []string) (split [][]string, err error) {
+	for _, pattern := range patterns {
+		parts := strings.Split(pattern, ".")
+		if len(parts) > 3 {
+			err = core.SDKErrorf(nil, fmt.Sprintf("pattern '%s' has more than three parts", pattern), "invalid-catalog-pattern", common.GetComponentInfo())
+			return
+		}
+		for _, part := range parts {
+			if _, err = path.Match(part, ""); err != nil {
+				err = core.SDKErrorf(err, fmt.Sprintf("invalid pattern '%s'", pattern), "invalid-catalog-pattern", common.GetComponentInfo())
+				return
+			}
+		}
+		split = append(split, parts)
+	}
+	return
+}
+
+// matchCatalogPattern reports whether the leading parts of pattern and names match.
+func matchCatalogPattern(pattern []string, names []string) bool {
+	for i := 0; i < len(pattern) && i < len(names); i++ {
+		if ok, _ := path.Match(pattern[i], names[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// walks reports whether the object with the given catalog, schema and table names passes Include and Exclude.
+func (walker *catalogWalker) walks(names ...string) bool {
+	for _, pattern := range walker.exclude {
+		if len(pattern) <= len(names) && matchCatalogPattern(pattern, names) {
+			return false
+		}
+	}
+	if len(walker.include) == 0 {
+		return true
+	}
+	for _, pattern := range walker.include {
+		if matchCatalogPattern(pattern, names) {
+			return true
+		}
+	}
+	return false
+}
+
+// call runs an API request once a concurrency slot is free.
+func (walker *catalogWalker) call(request func() error) error {
+	select {
+	case walker.slots <- struct{}{}:
+	case <-walker.ctx.Done():
+		return walker.ctx.Err()
+	}
+	defer func() {
+		<-walker.slots
+	}()
+	return request()
+}
+
+// spawn runs step in its own goroutine. The first error stops the walk.
+func (walker *catalogWalker) spawn(step func() error) {
+	walker.wg.Add(1)
+	go func() {
+		defer walker.wg.Done()
+		if err := step(); err != nil {
+			walker.errOnce.Do(func() {
+				walker.err = err
+				walker.cancel()
+			})
+		}
+	}()
+}
+
+// visit calls a visitor function, if set, and reports whether to descend.
+func (walker *catalogWalker) visit(visitor func() error) (descend bool, err error) {
+	if walker.ctx.Err() != nil {
+		return false, walker.ctx.Err()
+	}
+	if visitor != nil {
+		err = visitor()
+	}
+	if err == SkipCatalogMetadata {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// walkCatalog visits a catalog and spawns the walks of its schemas.
+func (walker *catalogWalker) walkCatalog(catalog *Catalog) error {
+	atomic.AddInt64(&walker.summary.Catalogs, 1)
+	visitor := walker.opts.Visitor
+	descend, err := walker.visit(func() error {
+		if visitor.Catalog == nil {
+			return nil
+		}
+		return visitor.Catalog(walker.ctx, catalog)
+	})
+	if !descend || (visitor.Schema == nil && visitor.Table == nil && visitor.Columns == nil) {
+		return err
+	}
+
+	var schemas *ListSchemasOKBody
+	err = walker.call(func() (err error) {
+		schemas, _, err = walker.watsonxData.ListSchemasWithContext(walker.ctx, &ListSchemasOptions{
+			EngineID:       walker.opts.EngineID,
+			CatalogID:      catalog.CatalogName,
+			AuthInstanceID: walker.opts.AuthInstanceID,
+			Headers:        walker.opts.Headers,
+		})
+		return
+	})
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "list-schemas-error")
+	}
+	for _, schema := range schemas.Schemas {
+		schemaPath := CatalogMetadataPath{
+			Catalog: *catalog.CatalogName,
+			Schema:  schema,
+		}
+		if walker.walks(schemaPath.Catalog, schemaPath.Schema) {
+			walker.spawn(func() error {
+				return walker.walkSchema(schemaPath)
+			})
+		}
+	}
+	return nil
+}
+
+// walkSchema visits a schema and spawns the walks of its tables.
+func (walker *catalogWalker) walkSchema(schemaPath CatalogMetadataPath) error {
+	atomic.AddInt64(&walker.summary.Schemas, 1)
+	visitor := walker.opts.Visitor
+	descend, err := walker.visit(func() error {
+		if visitor.Schema == nil {
+			return nil
+		}
+		return visitor.Schema(walker.ctx, schemaPath)
+	})
+	if !descend || (visitor.Table == nil && visitor.Columns == nil) {
+		return err
+	}
+
+	var tables *TableCollection
+	err = walker.call(func() (err error) {
+		tables, _, err = walker.watsonxData.ListTablesWithContext(walker.ctx, &ListTablesOptions{
+			CatalogID:      core.StringPtr(schemaPath.Catalog),
+			SchemaID:       core.StringPtr(schemaPath.Schema),
+			EngineID:       walker.opts.EngineID,
+			AuthInstanceID: walker.opts.AuthInstanceID,
+			Headers:        walker.opts.Headers,
+		})
+		return
+	})
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "list-tables-error")
+	}
+	for _, table := range tables.Tables {
+		tablePath := schemaPath
+		tablePath.Table = table
+		if walker.walks(tablePath.Catalog, tablePath.Schema, tablePath.Table) {
+			walker.spawn(func() error {
+				return walker.walkTable(tablePath)
+			})
+		}
+	}
+	return nil
+}
+
+// walkTable visits a table and its columns.
+func (walker *catalogWalker) walkTable(tablePath CatalogMetadataPath) error {
+	atomic.AddInt64(&walker.summary.Tables, 1)
+	visitor := walker.opts.Visitor
+	descend, err := walker.visit(func() error {
+		if visitor.Table == nil {
+			return nil
+		}
+		return visitor.Table(walker.ctx, tablePath)
+	})
+	if !descend || visitor.Columns == nil {
+		return err
+	}
+
+	var columns *ColumnCollection
+	err = walker.call(func() (err error) {
+		columns, _, err = walker.watsonxData.ListColumnsWithContext(walker.ctx, &ListColumnsOptions{
+			EngineID:       walker.opts.EngineID,
+			CatalogID:      core.StringPtr(tablePath.Catalog),
+			SchemaID:       core.StringPtr(tablePath.Schema),
+			TableID:        core.StringPtr(tablePath.Table),
+			AuthInstanceID: walker.opts.AuthInstanceID,
+			Headers:        walker.opts.Headers,
+		})
+		return
+	})
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "list-columns-error")
+	}
+	atomic.AddInt64(&walker.summary.Columns, int64(len(columns.Columns)))
+	_, err = walker.visit(func() error {
+		return visitor.Columns(walker.ctx, tablePath, columns.Columns)
+	})
+	return err
+}
+
+// WalkCatalogMetadataOptions : The WalkCatalogMetadata options.
+type WalkCatalogMetadataOptions struct {
+	// Engine used to list schemas, tables and columns.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Functions called at each level.
+	Visitor *CatalogMetadataVisitor `json:"-" validate:"required"`
+
+	// Glob patterns of the objects to walk, of the form catalog, catalog.schema or catalog.schema.table.
+	Include []string `json:"include,omitempty"`
+
+	// Glob patterns of the objects to skip, of the form catalog, catalog.schema or catalog.schema.table.
+	Exclude []string `json:"exclude,omitempty"`
+
+	// Maximum number of metadata requests in flight. Defaults to 4.
+	Concurrency *int64 `json:"concurrency,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWalkCatalogMetadataOptions : Instantiate WalkCatalogMetadataOptions
+func (*WatsonxDataV2) NewWalkCatalogMetadataOptions(engineID string, visitor *CatalogMetadataVisitor) *WalkCatalogMetadataOptions {
+	return &WalkCatalogMetadataOptions{
+		EngineID: core.StringPtr(engineID),
+		Visitor:  visitor,
+	}
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *WalkCatalogMetadataOptions) SetEngineID(engineID string) *WalkCatalogMetadataOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetVisitor : Allow user to set Visitor
+func (_options *WalkCatalogMetadataOptions) SetVisitor(visitor *CatalogMetadataVisitor) *WalkCatalogMetadataOptions {
+	_options.Visitor = visitor
+	return _options
+}
+
+// SetInclude : Allow user to set Include
+func (_options *WalkCatalogMetadataOptions) SetInclude(include []string) *WalkCatalogMetadataOptions {
+	_options.Include = include
+	return _options
+}
+
+// SetExclude : Allow user to set Exclude
+func (_options *WalkCatalogMetadataOptions) SetExclude(exclude []string) *WalkCatalogMetadataOptions {
+	_options.Exclude = exclude
+	return _options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *WalkCatalogMetadataOptions) SetConcurrency(concurrency int64) *WalkCatalogMetadataOptions {
+	_options.Concurrency = core.Int64Ptr(concurrency)
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *WalkCatalogMetadataOptions) SetAuthInstanceID(authInstanceID string) *WalkCatalogMetadataOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WalkCatalogMetadataOptions) SetHeaders(param map[string]string) *WalkCatalogMetadataOptions {
+	options.Headers = param
+	return options
+}
@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 catalog metadata walker`, func() {
+	var testServer *httptest.Server
+	var columnRequests int64
+	var inFlight, maxInFlight int64
+	BeforeEach(func() {
+		columnRequests = 0
+		inFlight, maxInFlight = 0, 0
+		schemas := map[string]string{
+			"sales": `["orders", "tmp_x"]`,
+			"crm":   `["people"]`,
+		}
+		tables := map[string]string{
+			"sales/orders": `["daily", "monthly"]`,
+			"sales/tmp_x":  `["t"]`,
+			"crm/people":   `["contacts"]`,
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			current := atomic.AddInt64(&inFlight, 1)
+			defer atomic.AddInt64(&inFlight, -1)
+			for {
+				seen := atomic.LoadInt64(&maxInFlight)
+				if current <= seen || atomic.CompareAndSwapInt64(&maxInFlight, seen, current) {
+					break
+				}
+			}
+
+			res.Header().Set("Content-type", "application/json")
+			parts := strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/")
+			switch len(parts) {
+			case 1:
+				fmt.Fprint(res, `{"catalogs": [
+					{"catalog_name": "sales", "associated_engines": ["presto01"]},
+					{"catalog_name": "crm"},
+					{"catalog_name": "other", "associated_engines": ["presto99"]}
+				]}`)
+			case 3:
+				Expect(req.URL.Query().Get("engine_id")).To(Equal("presto01"))
+				fmt.Fprintf(res, `{"response": {"message": "ok"}, "schemas": %s}`, schemas[parts[1]])
+			case 5:
+				fmt.Fprintf(res, `{"tables": %s}`, tables[parts[1]+"/"+parts[3]])
+			case 7:
+				atomic.AddInt64(&columnRequests, 1)
+				fmt.Fprint(res, `{"columns": [{"column_name": "id", "type": "bigint"}, {"column_name": "name", "type": "varchar"}]}`)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`WalkCatalogMetadata(walkCatalogMetadataOptions *WalkCatalogMetadataOptions)`, func() {
+		It(`Visit every level`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			summary, operationErr := watsonxDataService.WalkCatalogMetadata(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(summary).To(BeNil())
+
+			var mutex sync.Mutex
+			var visited []string
+			record := func(entry string) {
+				mutex.Lock()
+				visited = append(visited, entry)
+				mutex.Unlock()
+			}
+			visitor := &watsonxdatav2.CatalogMetadataVisitor{
+				Catalog: func(ctx context.Context, catalog *watsonxdatav2.Catalog) error {
+					record("catalog " + *catalog.CatalogName)
+					return nil
+				},
+				Schema: func(ctx context.Context, schema watsonxdatav2.CatalogMetadataPath) error {
+					record("schema " + schema.String())
+					return nil
+				},
+				Table: func(ctx context.Context, table watsonxdatav2.CatalogMetadataPath) error {
+					record("table " + table.String())
+					return nil
+				},
+				Columns: func(ctx context.Context, table watsonxdatav2.CatalogMetadataPath, columns []watsonxdatav2.Column) error {
+					record(fmt.Sprintf("columns %s %s:%s", table, *columns[0].ColumnName, *columns[0].Type))
+					return nil
+				},
+			}
+			walkCatalogMetadataOptionsModel := watsonxDataService.NewWalkCatalogMetadataOptions("presto01", visitor)
+			walkCatalogMetadataOptionsModel.SetExclude([]string{"*.tmp_*"})
+			walkCatalogMetadataOptionsModel.SetConcurrency(2)
+			summary, operationErr = watsonxDataService.WalkCatalogMetadata(walkCatalogMetadataOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(*summary).To(Equal(watsonxdatav2.CatalogMetadataWalkSummary{
+				Catalogs: 2,
+				Schemas:  2,
+				Tables:   3,
+				Columns:  6,
+			}))
+			sort.Strings(visited)
+			Expect(visited).To(Equal([]string{
+				"catalog crm",
+				"catalog sales",
+				"columns crm.people.contacts id:bigint",
+				"columns sales.orders.daily id:bigint",
+				"columns sales.orders.monthly id:bigint",
+				"schema crm.people",
+				"schema sales.orders",
+				"table crm.people.contacts",
+				"table sales.orders.daily",
+				"table sales.orders.monthly",
+			}))
+			Expect(atomic.LoadInt64(&maxInFlight)).To(BeNumerically("<=", 2))
+		})
+		It(`Filter, skip and stop`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			var mutex sync.Mutex
+			var tables []string
+			visitor := &watsonxdatav2.CatalogMetadataVisitor{
+				Table: func(ctx context.Context, table watsonxdatav2.CatalogMetadataPath) error {
+					mutex.Lock()
+					tables = append(tables, table.String())
+					mutex.Unlock()
+					return nil
+				},
+			}
+			walkCatalogMetadataOptionsModel := watsonxDataService.NewWalkCatalogMetadataOptions("presto01", visitor)
+			walkCatalogMetadataOptionsModel.SetInclude([]string{"sales.orders.d*", "crm"})
+			summary, operationErr := watsonxDataService.WalkCatalogMetadata(walkCatalogMetadataOptionsModel)
+			Expect(operationErr).To(BeNil())
+			sort.Strings(tables)
+			Expect(tables).To(Equal([]string{"crm.people.contacts", "sales.orders.daily"}))
+			Expect(summary.Columns).To(Equal(int64(0)))
+			Expect(atomic.LoadInt64(&columnRequests)).To(Equal(int64(0)))
+
+			tables = nil
+			visitor.Catalog = func(ctx context.Context, catalog *watsonxdatav2.Catalog) error {
+				if *catalog.CatalogName == "crm" {
+					return watsonxdatav2.SkipCatalogMetadata
+				}
+				return nil
+			}
+			walkCatalogMetadataOptionsModel.SetInclude(nil)
+			summary, operationErr = watsonxDataService.WalkCatalogMetadata(walkCatalogMetadataOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(tables).To(ConsistOf("sales.orders.daily", "sales.orders.monthly", "sales.tmp_x.t"))
+			Expect(summary.Schemas).To(Equal(int64(2)))
+
+			visitor.Table = func(ctx context.Context, table watsonxdatav2.CatalogMetadataPath) error {
+				return fmt.Errorf("cannot index %s", table)
+			}
+			_, operationErr = watsonxDataService.WalkCatalogMetadata(walkCatalogMetadataOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("cannot index sales."))
+
+			walkCatalogMetadataOptionsModel.SetExclude([]string{"a.b.c.d"})
+			_, operationErr = watsonxDataService.WalkCatalogMetadata(walkCatalogMetadataOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,457 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// RotateCredentials : Rotate a credential across registrations
+// Find every bucket registration and database registration that uses the given access key or username, patch each
+// one with the new secret and optionally restart the Presto and Prestissimo engines associated with the affected
+// catalogs. Failures of individual updates or restarts do not stop the rotation; they are reported in the Failures
+// field of the result. ADLS bucket registrations and Milvus services that use the access key are listed in the
+// Unsupported field, because the API models no patch of their credentials. Usernames such as "admin" are often
+// shared by unrelated databases, so a database is only updated if it is also selected by DatabaseIds or by
+// DatabaseHostname and DatabasePort; the other databases with the username are listed in the Skipped field.
+func (watsonxData *WatsonxDataV2) RotateCredentials(rotateCredentialsOptions *RotateCredentialsOptions) (result *CredentialRotationResult, err error) {
+	result, err = watsonxData.RotateCredentialsWithContext(context.Background(), rotateCredentialsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RotateCredentialsWithContext is an alternate form of the RotateCredentials method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) RotateCredentialsWithContext(ctx context.Context, rotateCredentialsOptions *RotateCredentialsOptions) (result *CredentialRotationResult, err error) {
+	err = core.ValidateNotNil(rotateCredentialsOptions, "rotateCredentialsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(rotateCredentialsOptions, "rotateCredentialsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	opts := rotateCredentialsOptions
+	identity := *opts.Identity
+	newIdentity := identity
+	if opts.NewIdentity != nil && *opts.NewIdentity != "" {
+		newIdentity = *opts.NewIdentity
+	}
+
+	result = &CredentialRotationResult{}
+	catalogs := map[string]bool{}
+
+	listBucketsOptions := &ListBucketRegistrationsOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	}
+	buckets, _, err := watsonxData.ListBucketRegistrationsWithContext(ctx, listBucketsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-buckets-error")
+		return
+	}
+	for _, bucket := range buckets.BucketRegistrations {
+		if bucket.BucketID == nil {
+			continue
+		}
+		target := CredentialRotationTarget{
+			Kind:        CredentialRotationTarget_Kind_Bucket,
+			ID:          *bucket.BucketID,
+			CatalogName: bucketCatalogName(&bucket),
+		}
+		if bucket.StorageDetails != nil && core.StringNilMapper(bucket.StorageDetails.AccessKey) == identity {
+			// ADLS registrations keep their keys in storage_details, which BucketRegistrationPatch does not model, so
+			// the bucket is reported for a manual update instead.
+			result.Unsupported = append(result.Unsupported, target)
+			continue
+		}
+		if bucket.BucketDetails == nil || core.StringNilMapper(bucket.BucketDetails.AccessKey) != identity {
+			continue
+		}
+		patch := &BucketRegistrationPatch{
+			BucketDetails: &BucketDetails{
+				AccessKey: core.StringPtr(newIdentity),
+				SecretKey: opts.NewSecret,
+			},
+		}
+		var body map[string]interface{}
+		body, err = patch.AsPatch()
+		if err != nil {
+			return
+		}
+		updateOptions := &UpdateBucketRegistrationOptions{
+			BucketID:       bucket.BucketID,
+			Body:           body,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		}
+		_, _, updateErr := watsonxData.UpdateBucketRegistrationWithContext(ctx, updateOptions)
+		result.recordUpdate(target, updateErr, catalogs)
+	}
+
+	listDatabasesOptions := &ListDatabaseRegistrationsOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	}
+	databases, _, err := watsonxData.ListDatabaseRegistrationsWithContext(ctx, listDatabasesOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-databases-error")
+		return
+	}
+	for _, database := range databases.DatabaseRegistrations {
+		if database.DatabaseID == nil || database.DatabaseDetails == nil {
+			continue
+		}
+		if core.StringNilMapper(database.DatabaseDetails.Username) != identity {
+			continue
+		}
+		target := CredentialRotationTarget{
+			Kind:        CredentialRotationTarget_Kind_Database,
+			ID:          *database.DatabaseID,
+			CatalogName: databaseCatalogName(&database),
+		}
+		if !opts.selectsDatabase(&database) {
+			result.Skipped = append(result.Skipped, target)
+			continue
+		}
+		patch := &DatabaseRegistrationPatch{
+			DatabaseDetails: &DatabaseRegistrationPatchDatabaseDetails{
+				Password: opts.NewSecret,
+				Username: core.StringPtr(newIdentity),
+			},
+		}
+		var body map[string]interface{}
+		body, err = patch.AsPatch()
+		if err != nil {
+			return
+		}
+		updateOptions := &UpdateDatabaseOptions{
+			DatabaseID:     database.DatabaseID,
+			Body:           body,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		}
+		_, _, updateErr := watsonxData.UpdateDatabaseWithContext(ctx, updateOptions)
+		result.recordUpdate(target, updateErr, catalogs)
+	}
+
+	listMilvusOptions := &ListMilvusServicesOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	}
+	milvusServices, _, err := watsonxData.ListMilvusServicesWithContext(ctx, listMilvusOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-milvus-services-error")
+		return
+	}
+	for _, service := range milvusServices.MilvusServices {
+		if service.ServiceID == nil || core.StringNilMapper(service.AccessKey) != identity {
+			continue
+		}
+		// MilvusServiceBucketPatch has no access or secret key, so the bucket credentials of a Milvus service cannot
+		// be rotated through the API. The service is reported for a manual update instead.
+		result.Unsupported = append(result.Unsupported, CredentialRotationTarget{
+			Kind: CredentialRotationTarget_Kind_MilvusService,
+			ID:   *service.ServiceID,
+		})
+	}
+
+	if opts.RestartEngines != nil && *opts.RestartEngines && len(catalogs) > 0 {
+		err = watsonxData.restartEnginesForCatalogs(ctx, opts, catalogs, result)
+	}
+	return
+}
+
+// selectsDatabase returns true if the database is one of DatabaseIds, or is on DatabaseHostname and, if it is set,
+// DatabasePort.
+func (opts *RotateCredentialsOptions) selectsDatabase(database *DatabaseRegistration) bool {
+	for _, databaseID := range opts.DatabaseIds {
+		if databaseID == *database.DatabaseID {
+			return true
+		}
+	}
+	if opts.DatabaseHostname == nil || core.StringNilMapper(database.DatabaseDetails.Hostname) != *opts.DatabaseHostname {
+		return false
+	}
+	return opts.DatabasePort == nil || (database.DatabaseDetails.Port != nil && *database.DatabaseDetails.Port == *opts.DatabasePort)
+}
+
+// recordUpdate records the outcome of a registration update and remembers its catalog for the restart phase.
+func (result *CredentialRotationResult) recordUpdate(target CredentialRotationTarget, err error, catalogs map[string]bool) {
+	if err != nil {
+		result.Failures = append(result.Failures, CredentialRotationFailure{
+			Target:    target,
+			Operation: CredentialRotationFailure_Operation_Update,
+			Err:       err,
+		})
+		return
+	}
+	result.Updated = append(result.Updated, target)
+	if target.CatalogName != "" {
+		catalogs[target.CatalogName] = true
+	}
+}
+
+// restartEnginesForCatalogs restarts every Presto and Prestissimo engine associated with one of the catalogs.
+func (watsonxData *WatsonxDataV2) restartEnginesForCatalogs(ctx context.Context, opts *RotateCredentialsOptions, catalogs map[string]bool, result *CredentialRotationResult) (err error) {
+	prestoEngines, _, err := watsonxData.ListPrestoEnginesWithContext(ctx, &ListPrestoEnginesOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-presto-engines-error")
+		return
+	}
+	for _, engine := range prestoEngines.PrestoEngines {
+		if engine.EngineID == nil || !associatedWithAny(engine.AssociatedCatalogs, catalogs) {
+			continue
+		}
+		target := CredentialRotationTarget{
+			Kind: CredentialRotationTarget_Kind_PrestoEngine,
+			ID:   *engine.EngineID,
+		}
+		_, _, restartErr := watsonxData.RestartPrestoEngineWithContext(ctx, &RestartPrestoEngineOptions{
+			EngineID:       engine.EngineID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+		result.recordRestart(target, restartErr)
+	}
+
+	prestissimoEngines, _, err := watsonxData.ListPrestissimoEnginesWithContext(ctx, &ListPrestissimoEnginesOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-prestissimo-engines-error")
+		return
+	}
+	for _, engine := range prestissimoEngines.PrestissimoEngines {
+		if engine.EngineID == nil || !associatedWithAny(engine.AssociatedCatalogs, catalogs) {
+			continue
+		}
+		target := CredentialRotationTarget{
+			Kind: CredentialRotationTarget_Kind_PrestissimoEngine,
+			ID:   *engine.EngineID,
+		}
+		_, _, restartErr := watsonxData.RestartPrestissimoEngineWithContext(ctx, &RestartPrestissimoEngineOptions{
+			EngineID:       engine.EngineID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+		result.recordRestart(target, restartErr)
+	}
+	return
+}
+
+func (result *CredentialRotationResult) recordRestart(target CredentialRotationTarget, err error) {
+	if err != nil {
+		result.Failures = append(result.Failures, CredentialRotationFailure{
+			Target:    target,
+			Operation: CredentialRotationFailure_Operation_Restart,
+			Err:       err,
+		})
+		return
+	}
+	result.RestartedEngines = append(result.RestartedEngines, target)
+}
+
+func associatedWithAny(associated []string, catalogs map[string]bool) bool {
+	for _, name := range associated {
+		if catalogs[name] {
+			return true
+		}
+	}
+	return false
+}
+
+func bucketCatalogName(bucket *BucketRegistration) string {
+	if bucket.AssociatedCatalog == nil {
+		return ""
+	}
+	return core.StringNilMapper(bucket.AssociatedCatalog.CatalogName)
+}
+
+func databaseCatalogName(database *DatabaseRegistration) string {
+	if database.AssociatedCatalog != nil && database.AssociatedCatalog.CatalogName != nil {
+		return *database.AssociatedCatalog.CatalogName
+	}
+	return core.StringNilMapper(database.CatalogName)
+}
+
+// RotateCredentialsOptions : The RotateCredentials options.
+type RotateCredentialsOptions struct {
+	// Access key (buckets, Milvus services) or username (databases) whose secret is rotated.
+	Identity *string `json:"identity" validate:"required,ne="`
+
+	// New secret key or password.
+	NewSecret *string `json:"new_secret" validate:"required,ne="`
+
+	// New access key or username, if the identity changes along with the secret.
+	NewIdentity *string `json:"new_identity,omitempty"`
+
+	// IDs of the database registrations to update. Databases that use the username are only updated if they are
+	// listed here or match DatabaseHostname.
+	DatabaseIds []string `json:"database_ids,omitempty"`
+
+	// Host name of the databases to update.
+	DatabaseHostname *string `json:"database_hostname,omitempty"`
+
+	// Port of the databases to update, together with DatabaseHostname. Any port matches if it is not set.
+	DatabasePort *int64 `json:"database_port,omitempty"`
+
+	// Restart the Presto and Prestissimo engines associated with the rotated catalogs.
+	RestartEngines *bool `json:"restart_engines,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewRotateCredentialsOptions : Instantiate RotateCredentialsOptions
+func (*WatsonxDataV2) NewRotateCredentialsOptions(identity string, newSecret string) *RotateCredentialsOptions {
+	return &RotateCredentialsOptions{
+		Identity:  core.StringPtr(identity),
+		NewSecret: core.StringPtr(newSecret),
+	}
+}
+
+// SetIdentity : Allow user to set Identity
+func (_options *RotateCredentialsOptions) SetIdentity(identity string) *RotateCredentialsOptions {
+	_options.Identity = core.StringPtr(identity)
+	return _options
+}
+
+// SetNewSecret : Allow user to set NewSecret
+func (_options *RotateCredentialsOptions) SetNewSecret(newSecret string) *RotateCredentialsOptions {
+	_options.NewSecret = core.StringPtr(newSecret)
+	return _options
+}
+
+// SetNewIdentity : Allow user to set NewIdentity
+func (_options *RotateCredentialsOptions) SetNewIdentity(newIdentity string) *RotateCredentialsOptions {
+	_options.NewIdentity = core.StringPtr(newIdentity)
+	return _options
+}
+
+// SetDatabaseIds : Allow user to set DatabaseIds
+func (_options *RotateCredentialsOptions) SetDatabaseIds(databaseIds []string) *RotateCredentialsOptions {
+	_options.DatabaseIds = databaseIds
+	return _options
+}
+
+// SetDatabaseHostname : Allow user to set DatabaseHostname
+func (_options *RotateCredentialsOptions) SetDatabaseHostname(databaseHostname string) *RotateCredentialsOptions {
+	_options.DatabaseHostname = core.StringPtr(databaseHostname)
+	return _options
+}
+
+// SetDatabasePort : Allow user to set DatabasePort
+func (_options *RotateCredentialsOptions) SetDatabasePort(databasePort int64) *RotateCredentialsOptions {
+	_options.DatabasePort = core.Int64Ptr(databasePort)
+	return _options
+}
+
+// SetRestartEngines : Allow user to set RestartEngines
+func (_options *RotateCredentialsOptions) SetRestartEngines(restartEngines bool) *RotateCredentialsOptions {
+	_options.RestartEngines = core.BoolPtr(restartEngines)
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *RotateCredentialsOptions) SetAuthInstanceID(authInstanceID string) *RotateCredentialsOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RotateCredentialsOptions) SetHeaders(param map[string]string) *RotateCredentialsOptions {
+	options.Headers = param
+	return options
+}
+
+// CredentialRotationResult : Outcome of a credential rotation.
+type CredentialRotationResult struct {
+	// Registrations that were updated with the new secret.
+	Updated []CredentialRotationTarget
+
+	// Engines that were restarted after the update.
+	RestartedEngines []CredentialRotationTarget
+
+	// Updates and restarts that failed.
+	Failures []CredentialRotationFailure
+
+	// Databases that use the username but are not selected by DatabaseIds or DatabaseHostname. They were not updated.
+	Skipped []CredentialRotationTarget
+
+	// Resources that use the identity but whose credentials cannot be updated through the API, such as ADLS
+	// buckets and Milvus services. Update them manually.
+	Unsupported []CredentialRotationTarget
+}
+
+// Failed returns true if any update or restart failed.
+func (result *CredentialRotationResult) Failed() bool {
+	return len(result.Failures) > 0
+}
+
+// CredentialRotationTarget : A resource touched by a credential rotation.
+type CredentialRotationTarget struct {
+	// Resource kind.
+	Kind string
+
+	// Bucket, database, service or engine ID.
+	ID string
+
+	// Catalog associated with the registration, if any.
+	CatalogName string
+}
+
+// Constants associated with the CredentialRotationTarget.Kind property.
+// Resource kind.
+const (
+	CredentialRotationTarget_Kind_Bucket            = "bucket"
+	CredentialRotationTarget_Kind_Database          = "database"
+	CredentialRotationTarget_Kind_MilvusService     = "milvus_service"
+	CredentialRotationTarget_Kind_PrestissimoEngine = "prestissimo_engine"
+	CredentialRotationTarget_Kind_PrestoEngine      = "presto_engine"
+)
+
+// CredentialRotationFailure : A failed step of a credential rotation.
+type CredentialRotationFailure struct {
+	// Resource the step applied to.
+	Target CredentialRotationTarget
+
+	// Step that failed.
+	Operation string
+
+	// Error returned by the service.
+	Err error
+}
+
+// Constants associated with the CredentialRotationFailure.Operation property.
+// Step that failed.
+const (
+	CredentialRotationFailure_Operation_Restart = "restart"
+	CredentialRotationFailure_Operation_Update  = "update"
+)
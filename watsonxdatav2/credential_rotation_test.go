@@ -0,0 +1,211 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 credential rotation`, func() {
+	var testServer *httptest.Server
+	Describe(`RotateCredentials(rotateCredentialsOptions *RotateCredentialsOptions)`, func() {
+		var mutex sync.Mutex
+		var patches map[string]map[string]interface{}
+		var restarts []string
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				patches = map[string]map[string]interface{}{}
+				restarts = nil
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					Expect(req.Header["Authinstanceid"]).ToNot(BeNil())
+					Expect(req.Header["Authinstanceid"][0]).To(Equal("testString"))
+					res.Header().Set("Content-type", "application/json")
+
+					mutex.Lock()
+					defer mutex.Unlock()
+					if req.Method == "PATCH" {
+						body := map[string]interface{}{}
+						Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+						patches[req.URL.EscapedPath()] = body
+						if req.URL.EscapedPath() == "/database_registrations/db-2" {
+							res.WriteHeader(500)
+							fmt.Fprint(res, `{"errors": [{"code": "internal", "message": "update failed"}]}`)
+							return
+						}
+						res.WriteHeader(200)
+						fmt.Fprint(res, `{}`)
+						return
+					}
+					if req.Method == "POST" {
+						restarts = append(restarts, req.URL.EscapedPath())
+						res.WriteHeader(201)
+						fmt.Fprint(res, `{"message": "restarted", "message_code": "success"}`)
+						return
+					}
+					switch req.URL.EscapedPath() {
+					case "/bucket_registrations":
+						fmt.Fprint(res, `{"bucket_registrations": [
+							{"bucket_id": "bucket-1", "associated_catalog": {"catalog_name": "iceberg_data"}, "bucket_details": {"access_key": "old-key", "bucket_name": "b1"}},
+							{"bucket_id": "bucket-2", "associated_catalog": {"catalog_name": "hive_data"}, "bucket_details": {"access_key": "other-key", "bucket_name": "b2"}},
+							{"bucket_id": "bucket-3", "associated_catalog": {"catalog_name": "adls_data"}, "storage_details": {"access_key": "old-key", "auth_mode": "account_key", "container_name": "c", "endpoint": "e", "storage_account_name": "s"}}
+						]}`)
+					case "/database_registrations":
+						fmt.Fprint(res, `{"database_registrations": [
+							{"database_id": "db-1", "associated_catalog": {"catalog_name": "pg_catalog"}, "database_details": {"username": "old-key", "hostname": "pg.example.com", "port": 5432}},
+							{"database_id": "db-2", "catalog_name": "mysql_catalog", "database_details": {"username": "old-key", "hostname": "mysql.example.com", "port": 3306}},
+							{"database_id": "db-3", "catalog_name": "db2_catalog", "database_details": {"username": "someone-else", "hostname": "pg.example.com", "port": 5432}},
+							{"database_id": "db-4", "catalog_name": "reporting_catalog", "database_details": {"username": "old-key", "hostname": "pg.example.com", "port": 5433}}
+						]}`)
+					case "/milvus_services":
+						fmt.Fprint(res, `{"milvus_services": [{"service_id": "milvus-1", "access_key": "old-key", "bucket_name": "vectors", "status_code": 200}]}`)
+					case "/presto_engines":
+						fmt.Fprint(res, `{"presto_engines": [
+							{"engine_id": "presto-1", "associated_catalogs": ["iceberg_data"], "external_host_name": "h", "status_code": 200},
+							{"engine_id": "presto-2", "associated_catalogs": ["hive_data"], "external_host_name": "h", "status_code": 200}
+						]}`)
+					case "/prestissimo_engines":
+						fmt.Fprint(res, `{"prestissimo_engines": [{"engine_id": "prestissimo-1", "associated_catalogs": ["pg_catalog", "mysql_catalog"], "external_host_name": "h", "status_code": 200}]}`)
+					default:
+						Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+					}
+				}))
+			})
+			It(`Invoke RotateCredentials successfully`, func() {
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(watsonxDataService).ToNot(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				result, operationErr := watsonxDataService.RotateCredentials(nil)
+				Expect(operationErr).NotTo(BeNil())
+				Expect(result).To(BeNil())
+
+				rotateCredentialsOptionsModel := watsonxDataService.NewRotateCredentialsOptions("old-key", "new-secret")
+				rotateCredentialsOptionsModel.SetDatabaseIds([]string{"db-1", "db-2"})
+				rotateCredentialsOptionsModel.SetRestartEngines(true)
+				rotateCredentialsOptionsModel.SetAuthInstanceID("testString")
+				result, operationErr = watsonxDataService.RotateCredentials(rotateCredentialsOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(result).ToNot(BeNil())
+
+				var updated []string
+				for _, target := range result.Updated {
+					updated = append(updated, target.ID)
+				}
+				Expect(updated).To(Equal([]string{"bucket-1", "db-1"}))
+				Expect(result.Unsupported).To(Equal([]watsonxdatav2.CredentialRotationTarget{
+					{Kind: watsonxdatav2.CredentialRotationTarget_Kind_Bucket, ID: "bucket-3", CatalogName: "adls_data"},
+					{Kind: watsonxdatav2.CredentialRotationTarget_Kind_MilvusService, ID: "milvus-1"},
+				}))
+				Expect(result.Skipped).To(Equal([]watsonxdatav2.CredentialRotationTarget{
+					{Kind: watsonxdatav2.CredentialRotationTarget_Kind_Database, ID: "db-4", CatalogName: "reporting_catalog"},
+				}))
+				Expect(result.Failed()).To(BeTrue())
+				Expect(result.Failures).To(HaveLen(1))
+				Expect(result.Failures[0].Target.ID).To(Equal("db-2"))
+				Expect(result.Failures[0].Operation).To(Equal(watsonxdatav2.CredentialRotationFailure_Operation_Update))
+
+				Expect(patches["/bucket_registrations/bucket-1"]).To(Equal(map[string]interface{}{
+					"bucket_details": map[string]interface{}{"access_key": "old-key", "secret_key": "new-secret"},
+				}))
+				Expect(patches).ToNot(HaveKey("/bucket_registrations/bucket-3"))
+				Expect(patches["/database_registrations/db-1"]).To(Equal(map[string]interface{}{
+					"database_details": map[string]interface{}{"username": "old-key", "password": "new-secret"},
+				}))
+				Expect(patches).ToNot(HaveKey("/milvus_services/milvus-1/bucket"))
+
+				Expect(restarts).To(Equal([]string{"/presto_engines/presto-1/restart", "/prestissimo_engines/prestissimo-1/restart"}))
+				Expect(result.RestartedEngines).To(HaveLen(2))
+			})
+			It(`Invoke RotateCredentials without restarting engines`, func() {
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				rotateCredentialsOptionsModel := new(watsonxdatav2.RotateCredentialsOptions)
+				rotateCredentialsOptionsModel.SetIdentity("old-key")
+				rotateCredentialsOptionsModel.SetNewSecret("new-secret")
+				rotateCredentialsOptionsModel.SetNewIdentity("new-key")
+				rotateCredentialsOptionsModel.SetAuthInstanceID("testString")
+				result, operationErr := watsonxDataService.RotateCredentials(rotateCredentialsOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(result.Updated).To(HaveLen(1))
+				Expect(result.Skipped).To(HaveLen(3))
+				Expect(result.RestartedEngines).To(BeEmpty())
+				Expect(restarts).To(BeEmpty())
+				Expect(patches["/bucket_registrations/bucket-1"]["bucket_details"]).To(HaveKeyWithValue("access_key", "new-key"))
+			})
+			It(`Invoke RotateCredentials for databases that share a username`, func() {
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				// db-1 and db-4 share the username and host but not the port
+				rotateCredentialsOptionsModel := watsonxDataService.NewRotateCredentialsOptions("old-key", "new-secret")
+				rotateCredentialsOptionsModel.SetDatabaseHostname("pg.example.com")
+				rotateCredentialsOptionsModel.SetDatabasePort(5432)
+				rotateCredentialsOptionsModel.SetAuthInstanceID("testString")
+				result, operationErr := watsonxDataService.RotateCredentials(rotateCredentialsOptionsModel)
+				Expect(operationErr).To(BeNil())
+				var updated, skipped []string
+				for _, target := range result.Updated {
+					updated = append(updated, target.ID)
+				}
+				for _, target := range result.Skipped {
+					skipped = append(skipped, target.ID)
+				}
+				Expect(updated).To(Equal([]string{"bucket-1", "db-1"}))
+				Expect(skipped).To(Equal([]string{"db-2", "db-4"}))
+				Expect(patches).To(HaveKey("/database_registrations/db-1"))
+				Expect(patches).ToNot(HaveKey("/database_registrations/db-4"))
+				Expect(patches).ToNot(HaveKey("/database_registrations/db-3"))
+			})
+			It(`Invoke RotateCredentials with missing required fields`, func() {
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				result, operationErr := watsonxDataService.RotateCredentials(new(watsonxdatav2.RotateCredentialsOptions))
+				Expect(operationErr).ToNot(BeNil())
+				Expect(result).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+})
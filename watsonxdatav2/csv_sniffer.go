@@ -0,0 +1,309 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// csvSniffSampleSize is the number of bytes read by SniffCsvDialect.
+const csvSniffSampleSize = 64 * 1024
+
+// csvSniffDelimiters are the field delimiter candidates, in order of preference.
+var csvSniffDelimiters = []rune{',', ';', '\t', '|'}
+
+// Constants associated with the CsvDialect.Encoding property.
+const (
+	CsvDialect_Encoding_Iso88591 = "iso-8859-1"
+	CsvDialect_Encoding_Utf16be  = "utf-16be"
+	CsvDialect_Encoding_Utf16le  = "utf-16le"
+	CsvDialect_Encoding_Utf8     = "utf-8"
+)
+
+// CsvDialect : The format of a CSV file, detected by SniffCsvDialect.
+// Delimiters and the escape character hold the actual characters, for example a tab, "\r\n" or a single backslash.
+type CsvDialect struct {
+	// Encoding of the file.
+	Encoding string `json:"encoding"`
+
+	// Whether the file starts with a byte order mark.
+	ByteOrderMark bool `json:"byte_order_mark"`
+
+	// Field delimiter.
+	FieldDelimiter string `json:"field_delimiter"`
+
+	// Line delimiter.
+	LineDelimiter string `json:"line_delimiter"`
+
+	// Whether fields are quoted with double quotes.
+	Quoted bool `json:"quoted"`
+
+	// Escape character inside quoted fields: a double quote when quotes are doubled, a backslash otherwise.
+	EscapeCharacter string `json:"escape_character"`
+
+	// Whether the first line is a header.
+	Header bool `json:"header"`
+}
+
+// SniffCsvFile : Detect the format of a local CSV file
+// See SniffCsvDialect.
+func SniffCsvFile(path string) (*CsvDialect, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "csv-file-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	return SniffCsvDialect(file)
+}
+
+// SniffCsvDialect : Detect the format of CSV data from its first 64 KiB
+// The encoding is taken from a byte order mark, or is UTF-8 when the sample is valid UTF-8 and ISO-8859-1
+// otherwise. The field delimiter is the candidate (comma, semicolon, tab or pipe) that occurs outside quotes the
+// same number of times on the most lines. The first line is a header when its values are distinct, non-empty and
+// not typed (numbers, booleans, dates), and either some column is typed in the other lines or none of its values
+// occurs again in its column.
+func SniffCsvDialect(reader io.Reader) (dialect *CsvDialect, err error) {
+	sample := make([]byte, csvSniffSampleSize)
+	n, err := io.ReadFull(reader, sample)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		err = core.SDKErrorf(err, "", "csv-read-error", common.GetComponentInfo())
+		return
+	}
+	err = nil
+	truncated := n == csvSniffSampleSize
+	sample = sample[:n]
+
+	dialect = &CsvDialect{
+		Encoding: CsvDialect_Encoding_Utf8,
+	}
+	var text string
+	switch {
+	case bytes.HasPrefix(sample, []byte{0xef, 0xbb, 0xbf}):
+		dialect.ByteOrderMark = true
+		text = string(sample[3:])
+	case bytes.HasPrefix(sample, []byte{0xff, 0xfe}):
+		dialect.ByteOrderMark = true
+		dialect.Encoding = CsvDialect_Encoding_Utf16le
+		text = decodeUtf16(sample[2:], false)
+	case bytes.HasPrefix(sample, []byte{0xfe, 0xff}):
+		dialect.ByteOrderMark = true
+		dialect.Encoding = CsvDialect_Encoding_Utf16be
+		text = decodeUtf16(sample[2:], true)
+	default:
+		text = string(sample)
+		if !utf8.Valid(sample) && !(truncated && validUtf8Prefix(sample)) {
+			dialect.Encoding = CsvDialect_Encoding_Iso88591
+			runes := make([]rune, len(sample))
+			for i, b := range sample {
+				runes[i] = rune(b)
+			}
+			text = string(runes)
+		}
+	}
+	if strings.TrimSpace(text) == "" {
+		err = core.SDKErrorf(nil, "the CSV data is empty", "csv-empty", common.GetComponentInfo())
+		return
+	}
+
+	lines, lineDelimiter, quoted, doubledQuotes := splitCsvLines(text)
+	if truncated && len(lines) > 1 {
+		lines = lines[:len(lines)-1]
+	}
+	dialect.LineDelimiter = lineDelimiter
+	dialect.Quoted = quoted
+	dialect.EscapeCharacter = "\\"
+	if doubledQuotes {
+		dialect.EscapeCharacter = `"`
+	}
+
+	delimiter := sniffCsvDelimiter(lines)
+	dialect.FieldDelimiter = string(delimiter)
+
+	csvReader := csv.NewReader(strings.NewReader(strings.Join(lines, "\n")))
+	csvReader.Comma = delimiter
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "csv-parse-error", common.GetComponentInfo())
+		return
+	}
+	dialect.Header = sniffCsvHeader(records)
+	return
+}
+
+// validUtf8Prefix reports whether data is valid UTF-8 apart from a character cut off at the end.
+func validUtf8Prefix(data []byte) bool {
+	for cut := 1; cut < utf8.UTFMax && cut < len(data); cut++ {
+		if utf8.Valid(data[:len(data)-cut]) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeUtf16 decodes UTF-16 data, dropping a trailing odd byte.
+func decodeUtf16(data []byte, bigEndian bool) string {
+	units := make([]uint16, len(data)/2)
+	for i := range units {
+		if bigEndian {
+			units[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+		} else {
+			units[i] = uint16(data[2*i+1])<<8 | uint16(data[2*i])
+		}
+	}
+	return string(utf16.Decode(units))
+}
+
+// splitCsvLines splits text into records on line breaks outside double quotes. It returns the line
+// delimiter of the first record, and whether quoted fields and doubled quotes inside them occur.
+func splitCsvLines(text string) (lines []string, lineDelimiter string, quoted bool, doubledQuotes bool) {
+	lineDelimiter = "\n"
+	inQuotes := false
+	start := 0
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == '"':
+			quoted = true
+			if inQuotes && i+1 < len(text) && text[i+1] == '"' {
+				doubledQuotes = true
+				i++
+			} else {
+				inQuotes = !inQuotes
+			}
+		case c == '\\' && inQuotes:
+			i++
+		case (c == '\n' || c == '\r') && !inQuotes:
+			end := i
+			if c == '\r' && i+1 < len(text) && text[i+1] == '\n' {
+				i++
+			}
+			if lines == nil {
+				lineDelimiter = text[end : i+1]
+			}
+			if strings.TrimSpace(text[start:end]) != "" {
+				lines = append(lines, text[start:end])
+			}
+			start = i + 1
+		}
+	}
+	if strings.TrimSpace(text[start:]) != "" {
+		lines = append(lines, text[start:])
+	}
+	return
+}
+
+// sniffCsvDelimiter returns the delimiter candidate whose count outside quotes is the same on the most lines,
+// preferring higher counts and then earlier candidates. It returns a comma when no candidate occurs.
+func sniffCsvDelimiter(lines []string) rune {
+	best, bestLines, bestCount := ',', 0, 0
+	for _, delimiter := range csvSniffDelimiters {
+		frequencies := map[int]int{}
+		for _, line := range lines {
+			count, inQuotes := 0, false
+			for _, c := range line {
+				if c == '"' {
+					inQuotes = !inQuotes
+				} else if c == delimiter && !inQuotes {
+					count++
+				}
+			}
+			frequencies[count]++
+		}
+		for count, matching := range frequencies {
+			if count > 0 && (matching > bestLines || (matching == bestLines && count > bestCount)) {
+				best, bestLines, bestCount = delimiter, matching, count
+			}
+		}
+	}
+	return best
+}
+
+// sniffCsvHeader reports whether the first record looks like a header.
+func sniffCsvHeader(records [][]string) bool {
+	if len(records) == 0 {
+		return false
+	}
+	seen := map[string]bool{}
+	for _, value := range records[0] {
+		value = strings.TrimSpace(value)
+		if value == "" || seen[value] {
+			return false
+		}
+		if prestoType, _ := inferSampleType([]string{value}); prestoType != "varchar" {
+			return false
+		}
+		seen[value] = true
+	}
+
+	repeated := false
+	for column, name := range records[0] {
+		var samples []string
+		for _, record := range records[1:] {
+			if column < len(record) && strings.TrimSpace(record[column]) != "" {
+				samples = append(samples, strings.TrimSpace(record[column]))
+				repeated = repeated || strings.TrimSpace(record[column]) == strings.TrimSpace(name)
+			}
+		}
+		if prestoType, _ := inferSampleType(samples); len(samples) > 0 && prestoType != "varchar" {
+			return true
+		}
+	}
+	return !repeated
+}
+
+// IngestionJobCsvProperty : Return the dialect as CSV properties of an ingestion job.
+func (dialect *CsvDialect) IngestionJobCsvProperty() *IngestionJobPrototypeCsvProperty {
+	return &IngestionJobPrototypeCsvProperty{
+		Encoding:        core.StringPtr(dialect.Encoding),
+		EscapeCharacter: core.StringPtr(dialect.EscapeCharacter),
+		FieldDelimiter:  core.StringPtr(dialect.FieldDelimiter),
+		Header:          core.BoolPtr(dialect.Header),
+		LineDelimiter:   core.StringPtr(dialect.LineDelimiter),
+	}
+}
+
+// PreviewCsvProperty : Return the dialect as CSV properties of an ingestion file preview.
+func (dialect *CsvDialect) PreviewCsvProperty() *PreviewIngestionFilePrototypeCsvProperty {
+	return &PreviewIngestionFilePrototypeCsvProperty{
+		Encoding:        core.StringPtr(dialect.Encoding),
+		EscapeCharacter: core.StringPtr(dialect.EscapeCharacter),
+		FieldDelimiter:  core.StringPtr(dialect.FieldDelimiter),
+		Header:          core.BoolPtr(dialect.Header),
+		LineDelimiter:   core.StringPtr(dialect.LineDelimiter),
+	}
+}
+
+// LocalFilesCsvProperty : Return the dialect as the base64 encoded CSV properties of a local files ingestion job.
+func (dialect *CsvDialect) LocalFilesCsvProperty() (string, error) {
+	encoded, err := json.Marshal(dialect.IngestionJobCsvProperty())
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "csv-property-json-error", common.GetComponentInfo())
+	}
+	return base64.StdEncoding.EncodeToString(encoded), nil
+}
@@ -0,0 +1,109 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 CSV sniffer`, func() {
+	Describe(`SniffCsvDialect(reader io.Reader)`, func() {
+		It(`Detect delimiters, quoting and header`, func() {
+			dialect, err := watsonxdatav2.SniffCsvDialect(strings.NewReader("id;name;amount\r\n1;\"Smith, \"\"J\"\"\";10,5\r\n2;Doe;3,2\r\n"))
+			Expect(err).To(BeNil())
+			Expect(dialect).To(Equal(&watsonxdatav2.CsvDialect{
+				Encoding:        "utf-8",
+				FieldDelimiter:  ";",
+				LineDelimiter:   "\r\n",
+				Quoted:          true,
+				EscapeCharacter: `"`,
+				Header:          true,
+			}))
+			Expect(dialect.IngestionJobCsvProperty()).To(Equal(&watsonxdatav2.IngestionJobPrototypeCsvProperty{
+				Encoding:        core.StringPtr("utf-8"),
+				EscapeCharacter: core.StringPtr(`"`),
+				FieldDelimiter:  core.StringPtr(";"),
+				Header:          core.BoolPtr(true),
+				LineDelimiter:   core.StringPtr("\r\n"),
+			}))
+			Expect(*dialect.PreviewCsvProperty().FieldDelimiter).To(Equal(";"))
+
+			localFilesCsvProperty, err := dialect.LocalFilesCsvProperty()
+			Expect(err).To(BeNil())
+			decoded, err := base64.StdEncoding.DecodeString(localFilesCsvProperty)
+			Expect(err).To(BeNil())
+			var property map[string]interface{}
+			Expect(json.Unmarshal(decoded, &property)).To(Succeed())
+			Expect(property["line_delimiter"]).To(Equal("\r\n"))
+			Expect(property["header"]).To(Equal(true))
+		})
+		It(`Detect encodings`, func() {
+			dialect, err := watsonxdatav2.SniffCsvDialect(strings.NewReader("1\tcaf\xe9\n2\tna\xefve\n"))
+			Expect(err).To(BeNil())
+			Expect(dialect.Encoding).To(Equal("iso-8859-1"))
+			Expect(dialect.FieldDelimiter).To(Equal("\t"))
+			Expect(dialect.EscapeCharacter).To(Equal("\\"))
+			Expect(dialect.Quoted).To(BeFalse())
+			Expect(dialect.Header).To(BeFalse())
+
+			dialect, err = watsonxdatav2.SniffCsvDialect(strings.NewReader("\xef\xbb\xbfcity|country\nParis|France\nLyon|France\n"))
+			Expect(err).To(BeNil())
+			Expect(dialect.Encoding).To(Equal("utf-8"))
+			Expect(dialect.ByteOrderMark).To(BeTrue())
+			Expect(dialect.FieldDelimiter).To(Equal("|"))
+			Expect(dialect.Header).To(BeTrue())
+
+			utf16le := []byte{0xff, 0xfe}
+			for _, unit := range utf16.Encode([]rune("name,when\nana,2025-06-01\n")) {
+				utf16le = append(utf16le, byte(unit), byte(unit>>8))
+			}
+			dialect, err = watsonxdatav2.SniffCsvDialect(strings.NewReader(string(utf16le)))
+			Expect(err).To(BeNil())
+			Expect(dialect.Encoding).To(Equal("utf-16le"))
+			Expect(dialect.FieldDelimiter).To(Equal(","))
+			Expect(dialect.Header).To(BeTrue())
+
+			_, err = watsonxdatav2.SniffCsvDialect(strings.NewReader("\n\n"))
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Sniff a local file`, func() {
+			dir, err := os.MkdirTemp("", "sniff")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "orders.csv")
+			Expect(os.WriteFile(path, []byte("a,b\na,c\n"), 0600)).To(Succeed())
+
+			dialect, err := watsonxdatav2.SniffCsvFile(path)
+			Expect(err).To(BeNil())
+			Expect(dialect.Header).To(BeFalse())
+			Expect(dialect.LineDelimiter).To(Equal("\n"))
+
+			_, err = watsonxdatav2.SniffCsvFile(filepath.Join(dir, "missing.csv"))
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
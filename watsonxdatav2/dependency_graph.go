@@ -0,0 +1,432 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// DependencyNode : A bucket, database, catalog, engine or driver in a DependencyGraph.
+type DependencyNode struct {
+	// Node ID, unique within the graph. See DependencyNodeID.
+	ID string `json:"id"`
+
+	// Resource kind.
+	Kind string `json:"kind"`
+
+	// Resource ID (bucket ID, database ID, engine ID, driver ID or catalog name).
+	ResourceID string `json:"resource_id"`
+
+	// Display name of the resource.
+	Name string `json:"name,omitempty"`
+}
+
+// Constants associated with the DependencyNode.Kind property.
+// Resource kind.
+const (
+	DependencyNode_Kind_Bucket            = "bucket"
+	DependencyNode_Kind_Catalog           = "catalog"
+	DependencyNode_Kind_Database          = "database"
+	DependencyNode_Kind_Driver            = "driver"
+	DependencyNode_Kind_Engine            = "engine"
+	DependencyNode_Kind_PrestissimoEngine = "prestissimo_engine"
+	DependencyNode_Kind_PrestoEngine      = "presto_engine"
+	DependencyNode_Kind_SparkEngine       = "spark_engine"
+)
+
+// DependencyEdge : States that the From node depends on the To node.
+type DependencyEdge struct {
+	// ID of the dependent node.
+	From string `json:"from"`
+
+	// ID of the node depended upon.
+	To string `json:"to"`
+
+	// Kind of relationship.
+	Relation string `json:"relation"`
+}
+
+// Constants associated with the DependencyEdge.Relation property.
+// Kind of relationship.
+const (
+	DependencyEdge_Relation_ConnectsTo  = "connects_to"
+	DependencyEdge_Relation_StoredIn    = "stored_in"
+	DependencyEdge_Relation_UsesCatalog = "uses_catalog"
+	DependencyEdge_Relation_UsesDriver  = "uses_driver"
+)
+
+// DependencyNodeID returns the ID of the node for the resource of the given kind.
+func DependencyNodeID(kind string, resourceID string) string {
+	return kind + "/" + resourceID
+}
+
+// DependencyGraph : In-memory graph of the relationships between buckets, databases, catalogs, engines and drivers.
+type DependencyGraph struct {
+	nodes map[string]*DependencyNode
+	edges map[DependencyEdge]bool
+}
+
+// NewDependencyGraph returns an empty DependencyGraph.
+func NewDependencyGraph() *DependencyGraph {
+	return &DependencyGraph{
+		nodes: map[string]*DependencyNode{},
+		edges: map[DependencyEdge]bool{},
+	}
+}
+
+// AddNode adds a node for the resource, or returns the existing one. A non-empty name replaces the current name.
+func (graph *DependencyGraph) AddNode(kind string, resourceID string, name string) *DependencyNode {
+	id := DependencyNodeID(kind, resourceID)
+	node, ok := graph.nodes[id]
+	if !ok {
+		node = &DependencyNode{
+			ID:         id,
+			Kind:       kind,
+			ResourceID: resourceID,
+		}
+		graph.nodes[id] = node
+	}
+	if name != "" {
+		node.Name = name
+	}
+	return node
+}
+
+// AddEdge records that the node from depends on the node to.
+func (graph *DependencyGraph) AddEdge(from *DependencyNode, to *DependencyNode, relation string) {
+	graph.edges[DependencyEdge{From: from.ID, To: to.ID, Relation: relation}] = true
+}
+
+// Node returns the node with the given ID, or nil.
+func (graph *DependencyGraph) Node(id string) *DependencyNode {
+	return graph.nodes[id]
+}
+
+// Nodes returns all nodes ordered by ID.
+func (graph *DependencyGraph) Nodes() (nodes []*DependencyNode) {
+	for _, node := range graph.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+	return
+}
+
+// Edges returns all edges ordered by source, target and relation.
+func (graph *DependencyGraph) Edges() (edges []DependencyEdge) {
+	for edge := range graph.edges {
+		edges = append(edges, edge)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		if edges[i].To != edges[j].To {
+			return edges[i].To < edges[j].To
+		}
+		return edges[i].Relation < edges[j].Relation
+	})
+	return
+}
+
+// Dependents returns every node that directly or transitively depends on the node with the given ID, that is,
+// everything that breaks if that resource is deleted. The result is ordered by ID.
+func (graph *DependencyGraph) Dependents(id string) []*DependencyNode {
+	return graph.reachable(id, func(edge DependencyEdge) (string, string) {
+		return edge.To, edge.From
+	})
+}
+
+// Dependencies returns every node that the node with the given ID directly or transitively depends on. The result
+// is ordered by ID.
+func (graph *DependencyGraph) Dependencies(id string) []*DependencyNode {
+	return graph.reachable(id, func(edge DependencyEdge) (string, string) {
+		return edge.From, edge.To
+	})
+}
+
+// DirectDependents returns the nodes that depend on the node with the given ID through a single edge, ordered by ID.
+func (graph *DependencyGraph) DirectDependents(id string) (nodes []*DependencyNode) {
+	for _, edge := range graph.Edges() {
+		if edge.To == id {
+			nodes = appendNode(nodes, graph.nodes[edge.From])
+		}
+	}
+	return
+}
+
+func appendNode(nodes []*DependencyNode, node *DependencyNode) []*DependencyNode {
+	for _, existing := range nodes {
+		if existing == node {
+			return nodes
+		}
+	}
+	return append(nodes, node)
+}
+
+// reachable walks the edges in the direction given by step, which maps an edge to its (source, target) pair.
+func (graph *DependencyGraph) reachable(id string, step func(DependencyEdge) (string, string)) (nodes []*DependencyNode) {
+	adjacent := map[string][]string{}
+	for edge := range graph.edges {
+		source, target := step(edge)
+		adjacent[source] = append(adjacent[source], target)
+	}
+	visited := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range adjacent[current] {
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			queue = append(queue, next)
+			nodes = append(nodes, graph.nodes[next])
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].ID < nodes[j].ID
+	})
+	return
+}
+
+// MarshalJSON returns the graph as a JSON object with "nodes" and "edges" arrays.
+func (graph *DependencyGraph) MarshalJSON() ([]byte, error) {
+	nodes := graph.Nodes()
+	edges := graph.Edges()
+	if nodes == nil {
+		nodes = []*DependencyNode{}
+	}
+	if edges == nil {
+		edges = []DependencyEdge{}
+	}
+	return json.Marshal(struct {
+		Nodes []*DependencyNode `json:"nodes"`
+		Edges []DependencyEdge  `json:"edges"`
+	}{nodes, edges})
+}
+
+// WriteJSON writes the graph to writer in JSON format.
+func (graph *DependencyGraph) WriteJSON(writer io.Writer) (err error) {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(graph)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "graph-json-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// WriteDOT writes the graph to writer in Graphviz DOT format.
+func (graph *DependencyGraph) WriteDOT(writer io.Writer) (err error) {
+	_, err = fmt.Fprintln(writer, "digraph watsonxdata {")
+	for _, node := range graph.Nodes() {
+		if err != nil {
+			break
+		}
+		label := node.Kind + "\n" + node.ResourceID
+		if node.Name != "" && node.Name != node.ResourceID {
+			label += "\n(" + node.Name + ")"
+		}
+		_, err = fmt.Fprintf(writer, "  %s [label=%s];\n", strconv.Quote(node.ID), strconv.Quote(label))
+	}
+	for _, edge := range graph.Edges() {
+		if err != nil {
+			break
+		}
+		_, err = fmt.Fprintf(writer, "  %s -> %s [label=%s];\n", strconv.Quote(edge.From), strconv.Quote(edge.To), strconv.Quote(edge.Relation))
+	}
+	if err == nil {
+		_, err = fmt.Fprintln(writer, "}")
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "graph-dot-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// BuildDependencyGraph : Build the dependency graph of the instance
+// List the bucket, database, driver registrations and the Presto, Prestissimo and Spark engines and build a graph of
+// the relationships between them. Catalogs are linked to their bucket or database, engines to their associated
+// catalogs and to the drivers registered for them.
+func (watsonxData *WatsonxDataV2) BuildDependencyGraph(buildDependencyGraphOptions *BuildDependencyGraphOptions) (result *DependencyGraph, err error) {
+	result, err = watsonxData.BuildDependencyGraphWithContext(context.Background(), buildDependencyGraphOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// BuildDependencyGraphWithContext is an alternate form of the BuildDependencyGraph method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) BuildDependencyGraphWithContext(ctx context.Context, buildDependencyGraphOptions *BuildDependencyGraphOptions) (result *DependencyGraph, err error) {
+	err = core.ValidateStruct(buildDependencyGraphOptions, "buildDependencyGraphOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	authInstanceID := buildDependencyGraphOptions.AuthInstanceID
+	headers := buildDependencyGraphOptions.Headers
+
+	graph := NewDependencyGraph()
+	catalogNode := func(name string) *DependencyNode {
+		return graph.AddNode(DependencyNode_Kind_Catalog, name, "")
+	}
+
+	buckets, _, err := watsonxData.ListBucketRegistrationsWithContext(ctx, &ListBucketRegistrationsOptions{
+		AuthInstanceID: authInstanceID,
+		Headers:        headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-buckets-error")
+		return
+	}
+	for _, bucket := range buckets.BucketRegistrations {
+		if bucket.BucketID == nil {
+			continue
+		}
+		node := graph.AddNode(DependencyNode_Kind_Bucket, *bucket.BucketID, core.StringNilMapper(bucket.BucketDisplayName))
+		if name := bucketCatalogName(&bucket); name != "" {
+			graph.AddEdge(catalogNode(name), node, DependencyEdge_Relation_StoredIn)
+		}
+	}
+
+	databases, _, err := watsonxData.ListDatabaseRegistrationsWithContext(ctx, &ListDatabaseRegistrationsOptions{
+		AuthInstanceID: authInstanceID,
+		Headers:        headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-databases-error")
+		return
+	}
+	for _, database := range databases.DatabaseRegistrations {
+		if database.DatabaseID == nil {
+			continue
+		}
+		node := graph.AddNode(DependencyNode_Kind_Database, *database.DatabaseID, core.StringNilMapper(database.DatabaseDisplayName))
+		if name := databaseCatalogName(&database); name != "" {
+			graph.AddEdge(catalogNode(name), node, DependencyEdge_Relation_ConnectsTo)
+		}
+	}
+
+	engineNodes := map[string]*DependencyNode{}
+	addEngine := func(kind string, engineID *string, displayName *string, catalogs []string) {
+		if engineID == nil {
+			return
+		}
+		node := graph.AddNode(kind, *engineID, core.StringNilMapper(displayName))
+		engineNodes[*engineID] = node
+		for _, name := range catalogs {
+			graph.AddEdge(node, catalogNode(name), DependencyEdge_Relation_UsesCatalog)
+		}
+	}
+
+	prestoEngines, _, err := watsonxData.ListPrestoEnginesWithContext(ctx, &ListPrestoEnginesOptions{
+		AuthInstanceID: authInstanceID,
+		Headers:        headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-presto-engines-error")
+		return
+	}
+	for _, engine := range prestoEngines.PrestoEngines {
+		addEngine(DependencyNode_Kind_PrestoEngine, engine.EngineID, engine.EngineDisplayName, engine.AssociatedCatalogs)
+	}
+
+	prestissimoEngines, _, err := watsonxData.ListPrestissimoEnginesWithContext(ctx, &ListPrestissimoEnginesOptions{
+		AuthInstanceID: authInstanceID,
+		Headers:        headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-prestissimo-engines-error")
+		return
+	}
+	for _, engine := range prestissimoEngines.PrestissimoEngines {
+		addEngine(DependencyNode_Kind_PrestissimoEngine, engine.EngineID, engine.EngineDisplayName, engine.AssociatedCatalogs)
+	}
+
+	sparkEngines, _, err := watsonxData.ListSparkEnginesWithContext(ctx, &ListSparkEnginesOptions{
+		AuthInstanceID: authInstanceID,
+		Headers:        headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-spark-engines-error")
+		return
+	}
+	for _, engine := range sparkEngines.SparkEngines {
+		addEngine(DependencyNode_Kind_SparkEngine, engine.EngineID, engine.EngineDisplayName, engine.AssociatedCatalogs)
+	}
+
+	drivers, _, err := watsonxData.ListDriverRegistrationWithContext(ctx, &ListDriverRegistrationOptions{
+		AuthInstanceID: authInstanceID,
+		Headers:        headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-drivers-error")
+		return
+	}
+	for _, driver := range drivers.DriverRegistrations {
+		if driver.DriverID == nil {
+			continue
+		}
+		node := graph.AddNode(DependencyNode_Kind_Driver, *driver.DriverID, core.StringNilMapper(driver.DriverName))
+		for _, engineID := range driver.AssociatedEngines {
+			engine, ok := engineNodes[engineID]
+			if !ok {
+				// The driver is associated with an engine of a type that is not listed above.
+				engine = graph.AddNode(DependencyNode_Kind_Engine, engineID, "")
+			}
+			graph.AddEdge(engine, node, DependencyEdge_Relation_UsesDriver)
+		}
+	}
+
+	result = graph
+	return
+}
+
+// BuildDependencyGraphOptions : The BuildDependencyGraph options.
+type BuildDependencyGraphOptions struct {
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewBuildDependencyGraphOptions : Instantiate BuildDependencyGraphOptions
+func (*WatsonxDataV2) NewBuildDependencyGraphOptions() *BuildDependencyGraphOptions {
+	return &BuildDependencyGraphOptions{}
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *BuildDependencyGraphOptions) SetAuthInstanceID(authInstanceID string) *BuildDependencyGraphOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *BuildDependencyGraphOptions) SetHeaders(param map[string]string) *BuildDependencyGraphOptions {
+	options.Headers = param
+	return options
+}
@@ -0,0 +1,145 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// dependencyGraphResponses are the list responses used to build the test dependency graph.
+var dependencyGraphResponses = map[string]string{
+	"/bucket_registrations": `{"bucket_registrations": [
+		{"bucket_id": "bucket-1", "bucket_display_name": "lake", "associated_catalog": {"catalog_name": "iceberg_data"}},
+		{"bucket_id": "bucket-2", "associated_catalog": {"catalog_name": "hive_data"}}
+	]}`,
+	"/database_registrations": `{"database_registrations": [{"database_id": "db-1", "database_display_name": "orders", "catalog_name": "pg_catalog"}]}`,
+	"/presto_engines":         `{"presto_engines": [{"engine_id": "presto01", "associated_catalogs": ["iceberg_data", "pg_catalog"], "external_host_name": "h", "status_code": 200}]}`,
+	"/prestissimo_engines":    `{"prestissimo_engines": [{"engine_id": "prestissimo01", "associated_catalogs": ["hive_data"], "external_host_name": "h", "status_code": 200}]}`,
+	"/spark_engines":          `{"spark_engines": [{"engine_id": "spark01", "associated_catalogs": ["iceberg_data"]}]}`,
+	"/driver_registrations":   `{"driver_registrations": [{"driver_id": "driver-1", "driver_name": "db2", "associated_engines": ["presto01", "netezza01"]}]}`,
+}
+
+// newDependencyGraphTestServer returns a mock server answering the list calls made by BuildDependencyGraph.
+func newDependencyGraphTestServer(responses map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+
+		Expect(req.Method).To(Equal("GET"))
+		body, ok := responses[req.URL.EscapedPath()]
+		Expect(ok).To(BeTrue(), req.URL.EscapedPath())
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprint(res, body)
+	}))
+}
+
+var _ = Describe(`WatsonxDataV2 dependency graph`, func() {
+	var testServer *httptest.Server
+	Describe(`BuildDependencyGraph(buildDependencyGraphOptions *BuildDependencyGraphOptions)`, func() {
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				testServer = newDependencyGraphTestServer(dependencyGraphResponses)
+			})
+			It(`Invoke BuildDependencyGraph successfully`, func() {
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				graph, operationErr := watsonxDataService.BuildDependencyGraph(nil)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(graph).To(BeNil())
+
+				graph, operationErr = watsonxDataService.BuildDependencyGraph(watsonxDataService.NewBuildDependencyGraphOptions())
+				Expect(operationErr).To(BeNil())
+				Expect(graph.Nodes()).To(HaveLen(11))
+
+				var ids []string
+				for _, node := range graph.Dependents(watsonxdatav2.DependencyNodeID(watsonxdatav2.DependencyNode_Kind_Bucket, "bucket-1")) {
+					ids = append(ids, node.ID)
+				}
+				Expect(ids).To(Equal([]string{"catalog/iceberg_data", "presto_engine/presto01", "spark_engine/spark01"}))
+
+				ids = nil
+				for _, node := range graph.Dependents("driver/driver-1") {
+					ids = append(ids, node.ID)
+				}
+				Expect(ids).To(Equal([]string{"engine/netezza01", "presto_engine/presto01"}))
+
+				ids = nil
+				for _, node := range graph.Dependencies("presto_engine/presto01") {
+					ids = append(ids, node.ID)
+				}
+				Expect(ids).To(Equal([]string{"bucket/bucket-1", "catalog/iceberg_data", "catalog/pg_catalog", "database/db-1", "driver/driver-1"}))
+
+				Expect(graph.DirectDependents("catalog/iceberg_data")).To(HaveLen(2))
+				Expect(graph.Node("bucket/bucket-1").Name).To(Equal("lake"))
+				Expect(graph.Dependents("bucket/unknown")).To(BeEmpty())
+			})
+			It(`Export the graph as DOT and JSON`, func() {
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				graph, operationErr := watsonxDataService.BuildDependencyGraph(watsonxDataService.NewBuildDependencyGraphOptions())
+				Expect(operationErr).To(BeNil())
+
+				dot := new(bytes.Buffer)
+				Expect(graph.WriteDOT(dot)).To(Succeed())
+				Expect(dot.String()).To(HavePrefix("digraph watsonxdata {\n"))
+				Expect(dot.String()).To(ContainSubstring(`"catalog/iceberg_data" -> "bucket/bucket-1" [label="stored_in"];`))
+				Expect(dot.String()).To(ContainSubstring(`"bucket/bucket-1" [label="bucket\nbucket-1\n(lake)"];`))
+
+				out := new(bytes.Buffer)
+				Expect(graph.WriteJSON(out)).To(Succeed())
+				var exported struct {
+					Nodes []watsonxdatav2.DependencyNode `json:"nodes"`
+					Edges []watsonxdatav2.DependencyEdge `json:"edges"`
+				}
+				Expect(json.Unmarshal(out.Bytes(), &exported)).To(Succeed())
+				Expect(exported.Nodes).To(HaveLen(11))
+				Expect(exported.Edges).To(ContainElement(watsonxdatav2.DependencyEdge{
+					From:     "presto_engine/presto01",
+					To:       "driver/driver-1",
+					Relation: watsonxdatav2.DependencyEdge_Relation_UsesDriver,
+				}))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`DependencyGraph`, func() {
+		It(`Export an empty graph`, func() {
+			out, err := json.Marshal(watsonxdatav2.NewDependencyGraph())
+			Expect(err).To(BeNil())
+			Expect(string(out)).To(Equal(`{"nodes":[],"edges":[]}`))
+		})
+	})
+})
@@ -0,0 +1,515 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// driverJarContentType is the content type used when uploading a JAR file.
+const driverJarContentType = "application/java-archive"
+
+// defaultDriverChecksumsFile is the name of the default driver checksum store file.
+const defaultDriverChecksumsFile = "driver-checksums.json"
+
+// Manifest attributes consulted, in order, for the driver name and version.
+var (
+	driverJarNameAttributes    = []string{"Implementation-Title", "Bundle-Name", "Specification-Title"}
+	driverJarVersionAttributes = []string{"Implementation-Version", "Bundle-Version", "Specification-Version"}
+)
+
+// DriverJarInfo : Driver details read from a local JAR file.
+type DriverJarInfo struct {
+	// Driver name, taken from the manifest or, if absent, the file name.
+	DriverName string
+
+	// Driver version, taken from the manifest.
+	Version string
+
+	// Hex encoded SHA-256 checksum of the JAR file.
+	Checksum string
+}
+
+// ReadDriverJarInfo reads the driver name and version from the manifest of the JAR file at jarPath and computes the
+// checksum of the file.
+func ReadDriverJarInfo(jarPath string) (info *DriverJarInfo, err error) {
+	file, err := os.Open(jarPath)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "jar-open-error", common.GetComponentInfo())
+		return
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "jar-read-error", common.GetComponentInfo())
+		return
+	}
+
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("'%s' is not a valid JAR file", jarPath), "jar-format-error", common.GetComponentInfo())
+		return
+	}
+
+	attributes := map[string]string{}
+	for _, entry := range archive.File {
+		if !strings.EqualFold(entry.Name, "META-INF/MANIFEST.MF") {
+			continue
+		}
+		var manifest io.ReadCloser
+		manifest, err = entry.Open()
+		if err != nil {
+			err = core.SDKErrorf(err, "", "jar-manifest-error", common.GetComponentInfo())
+			return
+		}
+		attributes, err = parseJarManifest(manifest)
+		manifest.Close()
+		if err != nil {
+			err = core.SDKErrorf(err, "", "jar-manifest-error", common.GetComponentInfo())
+			return
+		}
+		break
+	}
+
+	info = &DriverJarInfo{
+		DriverName: firstAttribute(attributes, driverJarNameAttributes),
+		Version:    firstAttribute(attributes, driverJarVersionAttributes),
+		Checksum:   hex.EncodeToString(hash.Sum(nil)),
+	}
+	if info.DriverName == "" {
+		info.DriverName = strings.TrimSuffix(filepath.Base(jarPath), filepath.Ext(jarPath))
+	}
+	return
+}
+
+// parseJarManifest parses the main section of a JAR manifest, joining continuation lines.
+func parseJarManifest(reader io.Reader) (attributes map[string]string, err error) {
+	attributes = map[string]string{}
+	scanner := bufio.NewScanner(reader)
+	var lastKey string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			// The main section ends at the first blank line.
+			break
+		}
+		if strings.HasPrefix(line, " ") && lastKey != "" {
+			attributes[lastKey] += line[1:]
+			continue
+		}
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		lastKey = strings.TrimSpace(key)
+		attributes[lastKey] = strings.TrimSpace(value)
+	}
+	err = scanner.Err()
+	return
+}
+
+func firstAttribute(attributes map[string]string, keys []string) string {
+	for _, key := range keys {
+		if value := attributes[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// DriverChecksumStore : Records the checksum of the JAR uploaded for each driver registration.
+// The service does not return driver checksums, so RegisterDriverJar keeps them to tell a re-registration of the same
+// JAR from a different JAR with the same name and version. Implementations must be safe for concurrent use.
+type DriverChecksumStore interface {
+	// Lookup returns the checksum recorded for a driver ID.
+	Lookup(driverID string) (checksum string, found bool, err error)
+
+	// Record stores the checksum of the JAR registered as a driver ID.
+	Record(driverID string, checksum string) error
+}
+
+// LocalDriverChecksumStore : A DriverChecksumStore kept in a local JSON file that maps driver IDs to checksums.
+type LocalDriverChecksumStore struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewLocalDriverChecksumStore : Instantiate LocalDriverChecksumStore
+// The file and its directory are created on the first record.
+func NewLocalDriverChecksumStore(path string) *LocalDriverChecksumStore {
+	return &LocalDriverChecksumStore{
+		path: path,
+	}
+}
+
+// DefaultDriverChecksumStorePath : Return the path of the default driver checksum store
+// This is watsonxdata/driver-checksums.json in the user configuration directory, or in the working directory if
+// there is none.
+func DefaultDriverChecksumStorePath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return defaultDriverChecksumsFile
+	}
+	return filepath.Join(dir, "watsonxdata", defaultDriverChecksumsFile)
+}
+
+// Lookup returns the checksum recorded for a driver ID.
+func (store *LocalDriverChecksumStore) Lookup(driverID string) (checksum string, found bool, err error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	checksums := map[string]string{}
+	if err = readJSONFile(store.path, &checksums); err != nil {
+		err = core.SDKErrorf(err, "", "checksum-store-error", common.GetComponentInfo())
+		return
+	}
+	checksum, found = checksums[driverID]
+	return
+}
+
+// Record stores the checksum of the JAR registered as a driver ID.
+func (store *LocalDriverChecksumStore) Record(driverID string, checksum string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	checksums := map[string]string{}
+	err := readJSONFile(store.path, &checksums)
+	if err == nil {
+		checksums[driverID] = checksum
+		err = writeJSONFile(store.path, checksums)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "checksum-store-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// readJSONFile decodes the JSON file at path into value, leaving value unchanged if the file does not exist or is
+// empty.
+func readJSONFile(path string, value interface{}) error {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err == nil && len(content) > 0 {
+		err = json.Unmarshal(content, value)
+	}
+	return err
+}
+
+// writeJSONFile replaces the file at path with value as indented JSON, creating its directory if needed. The file is
+// written to a temporary file first and renamed, so readers never see a partial file.
+func writeJSONFile(path string, value interface{}) error {
+	encoded, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = temp.Write(append(encoded, '\n'))
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(temp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(temp.Name())
+	}
+	return err
+}
+
+// RegisterDriverJar : Register a driver from a local JAR file
+// Read the driver name and version from the JAR manifest, upload the JAR unless a driver registration with the same
+// name and version already exists, and associate the driver with the requested engines. Calling it again with the same
+// JAR and engines makes no changes.
+//
+// With ChecksumStore set, the checksum of each uploaded JAR is recorded there and an existing registration is checked
+// against its recorded checksum: a different checksum is an error rather than a match, and a match is Verified. An
+// existing registration without a recorded checksum, or found without a ChecksumStore, is a match that is not
+// Verified. A JAR that was uploaded but whose checksum could not be recorded is still attached to the engines, with
+// the store error in RecordErr.
+func (watsonxData *WatsonxDataV2) RegisterDriverJar(registerDriverJarOptions *RegisterDriverJarOptions) (result *DriverJarRegistration, err error) {
+	result, err = watsonxData.RegisterDriverJarWithContext(context.Background(), registerDriverJarOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RegisterDriverJarWithContext is an alternate form of the RegisterDriverJar method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) RegisterDriverJarWithContext(ctx context.Context, registerDriverJarOptions *RegisterDriverJarOptions) (result *DriverJarRegistration, err error) {
+	err = core.ValidateNotNil(registerDriverJarOptions, "registerDriverJarOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(registerDriverJarOptions, "registerDriverJarOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := registerDriverJarOptions
+
+	info, err := ReadDriverJarInfo(*opts.JarPath)
+	if err != nil {
+		return
+	}
+	if opts.DriverName != nil {
+		info.DriverName = *opts.DriverName
+	}
+	if opts.Version != nil {
+		info.Version = *opts.Version
+	}
+	result = &DriverJarRegistration{
+		DriverName: info.DriverName,
+		Version:    info.Version,
+		Checksum:   info.Checksum,
+	}
+
+	drivers, _, err := watsonxData.ListDriverRegistrationWithContext(ctx, &ListDriverRegistrationOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-drivers-error")
+		return
+	}
+	for i := range drivers.DriverRegistrations {
+		driver := &drivers.DriverRegistrations[i]
+		if core.StringNilMapper(driver.DriverName) == info.DriverName &&
+			core.StringNilMapper(driver.Version) == info.Version &&
+			core.StringNilMapper(driver.ConnectionType) == *opts.ConnectionType {
+			result.Driver = driver
+			break
+		}
+	}
+	store := opts.ChecksumStore
+	if store != nil && result.Driver != nil && result.Driver.DriverID != nil {
+		checksum, found, lookupErr := store.Lookup(*result.Driver.DriverID)
+		if lookupErr != nil {
+			err = lookupErr
+			return
+		}
+		if found && checksum != info.Checksum {
+			err = core.SDKErrorf(nil, fmt.Sprintf("driver %s %s is already registered as %s from a different JAR (checksum %s, this JAR %s)", info.DriverName, info.Version, *result.Driver.DriverID, checksum, info.Checksum), "driver-checksum-mismatch", common.GetComponentInfo())
+			return
+		}
+		result.Verified = found
+	}
+
+	if result.Driver == nil {
+		var jar *os.File
+		jar, err = os.Open(*opts.JarPath)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "jar-open-error", common.GetComponentInfo())
+			return
+		}
+		defer jar.Close()
+		createOptions := &CreateDriverRegistrationOptions{
+			Driver:            jar,
+			DriverName:        core.StringPtr(info.DriverName),
+			ConnectionType:    opts.ConnectionType,
+			DriverContentType: core.StringPtr(driverJarContentType),
+			AuthInstanceID:    opts.AuthInstanceID,
+			Headers:           opts.Headers,
+		}
+		if info.Version != "" {
+			createOptions.Version = core.StringPtr(info.Version)
+		}
+		result.Driver, _, err = watsonxData.CreateDriverRegistrationWithContext(ctx, createOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "create-driver-error")
+			return
+		}
+		result.Uploaded = true
+		result.Verified = true
+		if store != nil && result.Driver.DriverID != nil {
+			result.RecordErr = store.Record(*result.Driver.DriverID, info.Checksum)
+		}
+	}
+
+	associated := map[string]bool{}
+	for _, engineID := range result.Driver.AssociatedEngines {
+		associated[engineID] = true
+	}
+	engines := append([]string{}, result.Driver.AssociatedEngines...)
+	for _, engineID := range opts.EngineIds {
+		if !associated[engineID] {
+			associated[engineID] = true
+			engines = append(engines, engineID)
+			result.AttachedEngines = append(result.AttachedEngines, engineID)
+		}
+	}
+	if len(result.AttachedEngines) == 0 {
+		return
+	}
+	if result.Driver.DriverID == nil {
+		err = core.SDKErrorf(nil, "driver registration has no driver_id", "missing-driver-id", common.GetComponentInfo())
+		return
+	}
+
+	// The full engine list is sent so the existing associations are kept.
+	prototype := &DriverRegistrationEnginePrototype{Engines: engines}
+	body, err := prototype.AsPatch()
+	if err != nil {
+		return
+	}
+	_, _, err = watsonxData.UpdateDriverEnginesWithContext(ctx, &UpdateDriverEnginesOptions{
+		DriverID:       result.Driver.DriverID,
+		Body:           body,
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "update-driver-engines-error")
+		return
+	}
+	result.Driver.AssociatedEngines = engines
+	return
+}
+
+// RegisterDriverJarOptions : The RegisterDriverJar options.
+type RegisterDriverJarOptions struct {
+	// Path of the JAR file to register.
+	JarPath *string `json:"jar_path" validate:"required,ne="`
+
+	// Driver connection type.
+	ConnectionType *string `json:"connection_type" validate:"required,ne="`
+
+	// Driver name, overriding the one read from the manifest.
+	DriverName *string `json:"driver_name,omitempty"`
+
+	// Driver version, overriding the one read from the manifest.
+	Version *string `json:"version,omitempty"`
+
+	// Engines the driver should be associated with.
+	EngineIds []string `json:"engine_ids,omitempty"`
+
+	// Store of the checksums of registered JARs, for example a LocalDriverChecksumStore at
+	// DefaultDriverChecksumStorePath. Without one, existing registrations are not checked.
+	ChecksumStore DriverChecksumStore `json:"-"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewRegisterDriverJarOptions : Instantiate RegisterDriverJarOptions
+func (*WatsonxDataV2) NewRegisterDriverJarOptions(jarPath string, connectionType string) *RegisterDriverJarOptions {
+	return &RegisterDriverJarOptions{
+		JarPath:        core.StringPtr(jarPath),
+		ConnectionType: core.StringPtr(connectionType),
+	}
+}
+
+// SetJarPath : Allow user to set JarPath
+func (_options *RegisterDriverJarOptions) SetJarPath(jarPath string) *RegisterDriverJarOptions {
+	_options.JarPath = core.StringPtr(jarPath)
+	return _options
+}
+
+// SetConnectionType : Allow user to set ConnectionType
+func (_options *RegisterDriverJarOptions) SetConnectionType(connectionType string) *RegisterDriverJarOptions {
+	_options.ConnectionType = core.StringPtr(connectionType)
+	return _options
+}
+
+// SetDriverName : Allow user to set DriverName
+func (_options *RegisterDriverJarOptions) SetDriverName(driverName string) *RegisterDriverJarOptions {
+	_options.DriverName = core.StringPtr(driverName)
+	return _options
+}
+
+// SetVersion : Allow user to set Version
+func (_options *RegisterDriverJarOptions) SetVersion(version string) *RegisterDriverJarOptions {
+	_options.Version = core.StringPtr(version)
+	return _options
+}
+
+// SetEngineIds : Allow user to set EngineIds
+func (_options *RegisterDriverJarOptions) SetEngineIds(engineIds []string) *RegisterDriverJarOptions {
+	_options.EngineIds = engineIds
+	return _options
+}
+
+// SetChecksumStore : Allow user to set ChecksumStore
+func (_options *RegisterDriverJarOptions) SetChecksumStore(checksumStore DriverChecksumStore) *RegisterDriverJarOptions {
+	_options.ChecksumStore = checksumStore
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *RegisterDriverJarOptions) SetAuthInstanceID(authInstanceID string) *RegisterDriverJarOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RegisterDriverJarOptions) SetHeaders(param map[string]string) *RegisterDriverJarOptions {
+	options.Headers = param
+	return options
+}
+
+// DriverJarRegistration : Outcome of registering a driver JAR.
+type DriverJarRegistration struct {
+	// The existing or newly created driver registration.
+	Driver *DriverRegistration
+
+	// Driver name used for the registration.
+	DriverName string
+
+	// Driver version used for the registration.
+	Version string
+
+	// Hex encoded SHA-256 checksum of the JAR file.
+	Checksum string
+
+	// True if the JAR was uploaded, false if a matching registration already existed.
+	Uploaded bool
+
+	// True if the registration is known to be from this JAR: it was uploaded, or its checksum in ChecksumStore
+	// matches.
+	Verified bool
+
+	// Engines newly associated with the driver.
+	AttachedEngines []string
+
+	// Error recording the checksum in ChecksumStore. The JAR was still uploaded.
+	RecordErr error
+}
@@ -0,0 +1,238 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// writeTestJar creates a JAR file containing only the given manifest.
+func writeTestJar(dir string, name string, manifest string) string {
+	jarPath := filepath.Join(dir, name)
+	file, err := os.Create(jarPath)
+	Expect(err).To(BeNil())
+	defer file.Close()
+	archive := zip.NewWriter(file)
+	entry, err := archive.Create("META-INF/MANIFEST.MF")
+	Expect(err).To(BeNil())
+	_, err = io.WriteString(entry, manifest)
+	Expect(err).To(BeNil())
+	Expect(archive.Close()).To(Succeed())
+	return jarPath
+}
+
+// failingChecksumStore is a DriverChecksumStore that records nothing.
+type failingChecksumStore struct{}
+
+func (failingChecksumStore) Lookup(driverID string) (string, bool, error) {
+	return "", false, nil
+}
+
+func (failingChecksumStore) Record(driverID string, checksum string) error {
+	return errors.New("store is read-only")
+}
+
+var _ = Describe(`WatsonxDataV2 driver registration`, func() {
+	var testServer *httptest.Server
+	var tempDir string
+	BeforeEach(func() {
+		var err error
+		tempDir, err = os.MkdirTemp("", "driver-jar")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+	Describe(`ReadDriverJarInfo(jarPath string)`, func() {
+		It(`Read name and version from the manifest`, func() {
+			jarPath := writeTestJar(tempDir, "postgresql-42.7.3.jar",
+				"Manifest-Version: 1.0\r\nImplementation-Title: PostgreSQL JDBC\r\n  Driver\r\nImplementation-Version: 42.7.3\r\n\r\nName: org/postgresql/\r\nImplementation-Title: ignored\r\n")
+			info, err := watsonxdatav2.ReadDriverJarInfo(jarPath)
+			Expect(err).To(BeNil())
+			Expect(info.DriverName).To(Equal("PostgreSQL JDBC Driver"))
+			Expect(info.Version).To(Equal("42.7.3"))
+			Expect(info.Checksum).To(HaveLen(64))
+		})
+		It(`Fall back to the file name when the manifest has no title`, func() {
+			jarPath := writeTestJar(tempDir, "custom-driver.jar", "Manifest-Version: 1.0\nBundle-Version: 2.1\n")
+			info, err := watsonxdatav2.ReadDriverJarInfo(jarPath)
+			Expect(err).To(BeNil())
+			Expect(info.DriverName).To(Equal("custom-driver"))
+			Expect(info.Version).To(Equal("2.1"))
+		})
+		It(`Return an error for a file that is not a JAR`, func() {
+			notJar := filepath.Join(tempDir, "driver.jar")
+			Expect(os.WriteFile(notJar, []byte("not a zip"), 0600)).To(Succeed())
+			_, err := watsonxdatav2.ReadDriverJarInfo(notJar)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`RegisterDriverJar(registerDriverJarOptions *RegisterDriverJarOptions)`, func() {
+		var existingDrivers string
+		var uploads int
+		var engineUpdates []map[string]interface{}
+		Context(`Using mock server endpoint`, func() {
+			BeforeEach(func() {
+				uploads = 0
+				engineUpdates = nil
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					res.Header().Set("Content-type", "application/json")
+					switch {
+					case req.Method == "GET" && req.URL.EscapedPath() == "/driver_registrations":
+						fmt.Fprint(res, existingDrivers)
+					case req.Method == "POST" && req.URL.EscapedPath() == "/driver_registrations":
+						Expect(req.ParseMultipartForm(1 << 20)).To(Succeed())
+						Expect(req.FormValue("driver_name")).To(Equal("PostgreSQL JDBC"))
+						Expect(req.FormValue("version")).To(Equal("42.7.3"))
+						Expect(req.FormValue("connection_type")).To(Equal("postgresql"))
+						Expect(req.MultipartForm.File["driver"]).To(HaveLen(1))
+						uploads++
+						res.WriteHeader(201)
+						fmt.Fprint(res, `{"driver_id": "driver-new", "driver_name": "PostgreSQL JDBC", "version": "42.7.3", "connection_type": "postgresql"}`)
+					case req.Method == "PATCH":
+						body := map[string]interface{}{}
+						Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+						body["path"] = req.URL.EscapedPath()
+						engineUpdates = append(engineUpdates, body)
+						fmt.Fprint(res, `{"engines": []}`)
+					default:
+						Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+					}
+				}))
+			})
+			It(`Invoke RegisterDriverJar when the driver is not registered`, func() {
+				existingDrivers = `{"driver_registrations": [{"driver_id": "driver-old", "driver_name": "PostgreSQL JDBC", "version": "42.6.0", "connection_type": "postgresql"}]}`
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				// Invoke operation with nil options model (negative test)
+				result, operationErr := watsonxDataService.RegisterDriverJar(nil)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(result).To(BeNil())
+
+				jarPath := writeTestJar(tempDir, "postgresql.jar", "Implementation-Title: PostgreSQL JDBC\nImplementation-Version: 42.7.3\n")
+				store := watsonxdatav2.NewLocalDriverChecksumStore(filepath.Join(tempDir, "state", "checksums.json"))
+				registerDriverJarOptionsModel := watsonxDataService.NewRegisterDriverJarOptions(jarPath, "postgresql")
+				registerDriverJarOptionsModel.SetEngineIds([]string{"presto01"})
+				registerDriverJarOptionsModel.SetChecksumStore(store)
+				result, operationErr = watsonxDataService.RegisterDriverJar(registerDriverJarOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(result.Uploaded).To(BeTrue())
+				Expect(result.Verified).To(BeTrue())
+				Expect(*result.Driver.DriverID).To(Equal("driver-new"))
+				Expect(result.AttachedEngines).To(Equal([]string{"presto01"}))
+				Expect(uploads).To(Equal(1))
+				Expect(engineUpdates).To(HaveLen(1))
+				Expect(engineUpdates[0]["path"]).To(Equal("/driver_registrations/driver-new/engines"))
+				Expect(engineUpdates[0]["engines"]).To(Equal([]interface{}{"presto01"}))
+
+				checksum, found, err := store.Lookup("driver-new")
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+				Expect(checksum).To(Equal(result.Checksum))
+			})
+			It(`Invoke RegisterDriverJar when the checksum cannot be recorded`, func() {
+				existingDrivers = `{"driver_registrations": []}`
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				jarPath := writeTestJar(tempDir, "postgresql.jar", "Implementation-Title: PostgreSQL JDBC\nImplementation-Version: 42.7.3\n")
+				registerDriverJarOptionsModel := watsonxDataService.NewRegisterDriverJarOptions(jarPath, "postgresql")
+				registerDriverJarOptionsModel.SetEngineIds([]string{"presto01"})
+				registerDriverJarOptionsModel.SetChecksumStore(failingChecksumStore{})
+				result, operationErr := watsonxDataService.RegisterDriverJar(registerDriverJarOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(result.Uploaded).To(BeTrue())
+				Expect(result.RecordErr).To(MatchError("store is read-only"))
+				Expect(result.AttachedEngines).To(Equal([]string{"presto01"}))
+				Expect(engineUpdates).To(HaveLen(1))
+				Expect(engineUpdates[0]["path"]).To(Equal("/driver_registrations/driver-new/engines"))
+			})
+			It(`Invoke RegisterDriverJar when the same version is already registered`, func() {
+				existingDrivers = `{"driver_registrations": [{"driver_id": "driver-old", "driver_name": "PostgreSQL JDBC", "version": "42.7.3", "connection_type": "postgresql", "associated_engines": ["presto01"]}]}`
+				watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				jarPath := writeTestJar(tempDir, "postgresql.jar", "Implementation-Title: PostgreSQL JDBC\nImplementation-Version: 42.7.3\n")
+				registerDriverJarOptionsModel := watsonxDataService.NewRegisterDriverJarOptions(jarPath, "postgresql")
+				registerDriverJarOptionsModel.SetEngineIds([]string{"presto01"})
+				result, operationErr := watsonxDataService.RegisterDriverJar(registerDriverJarOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(result.Uploaded).To(BeFalse())
+				Expect(result.Verified).To(BeFalse())
+				Expect(result.AttachedEngines).To(BeEmpty())
+				Expect(uploads).To(Equal(0))
+				Expect(engineUpdates).To(BeEmpty())
+
+				// Without a recorded checksum the match is not verified, and nothing is recorded
+				storePath := filepath.Join(tempDir, "checksums.json")
+				store := watsonxdatav2.NewLocalDriverChecksumStore(storePath)
+				registerDriverJarOptionsModel.SetChecksumStore(store)
+				result, operationErr = watsonxDataService.RegisterDriverJar(registerDriverJarOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(result.Verified).To(BeFalse())
+				_, err := os.Stat(storePath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+
+				Expect(store.Record("driver-old", result.Checksum)).To(Succeed())
+				registerDriverJarOptionsModel.SetEngineIds([]string{"presto01", "prestissimo01"})
+				result, operationErr = watsonxDataService.RegisterDriverJar(registerDriverJarOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(result.Verified).To(BeTrue())
+				Expect(result.AttachedEngines).To(Equal([]string{"prestissimo01"}))
+				Expect(engineUpdates).To(HaveLen(1))
+				Expect(engineUpdates[0]["engines"]).To(Equal([]interface{}{"presto01", "prestissimo01"}))
+
+				// A different JAR with the same name and version is not the registered driver
+				otherJarPath := writeTestJar(tempDir, "postgresql-patched.jar", "Implementation-Title: PostgreSQL JDBC\nImplementation-Version: 42.7.3\nBuilt-By: someone else\n")
+				registerDriverJarOptionsModel.SetJarPath(otherJarPath)
+				result, operationErr = watsonxDataService.RegisterDriverJar(registerDriverJarOptionsModel)
+				Expect(operationErr).ToNot(BeNil())
+				Expect(operationErr.Error()).To(ContainSubstring("already registered as driver-old from a different JAR"))
+				Expect(uploads).To(Equal(0))
+				Expect(engineUpdates).To(HaveLen(1))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+})
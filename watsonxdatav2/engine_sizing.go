@@ -0,0 +1,371 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// NodeTypeCapacity : vCPU and memory of a single node.
+type NodeTypeCapacity struct {
+	// Virtual CPUs.
+	VCPU int64
+
+	// Memory in GB.
+	MemoryGB int64
+}
+
+// EngineSize : The coordinator and worker nodes of a predefined engine size.
+type EngineSize struct {
+	// Size config, for example "small".
+	SizeConfig string
+
+	// Coordinator nodes.
+	Coordinator NodeDescriptionBody
+
+	// Worker nodes.
+	Worker NodeDescriptionBody
+}
+
+// EngineCapacity : Node counts, vCPU and memory of an engine.
+type EngineCapacity struct {
+	// Coordinator and worker nodes.
+	Nodes int64
+
+	// vCPU of all nodes.
+	VCPU int64
+
+	// Memory of all nodes, in GB.
+	MemoryGB int64
+
+	// Worker nodes.
+	WorkerNodes int64
+
+	// vCPU of the worker nodes, which run the queries.
+	WorkerVCPU int64
+
+	// Memory of the worker nodes, in GB.
+	WorkerMemoryGB int64
+}
+
+// EngineSizingRequirements : The workload an engine must handle.
+type EngineSizingRequirements struct {
+	// Number of queries running at the same time.
+	Concurrency int64
+
+	// Data scanned by a typical query, in GB.
+	DataVolumeGB float64
+}
+
+// EngineSizingRecommendation : The engine size suggested for a workload.
+type EngineSizingRecommendation struct {
+	// Suggested size config. "custom" if no predefined size is large enough.
+	SizeConfig string
+
+	// Coordinator nodes.
+	Coordinator *NodeDescriptionBody
+
+	// Worker nodes.
+	Worker *NodeDescriptionBody
+
+	// Capacity of the suggested size.
+	Capacity *EngineCapacity
+
+	// Worker vCPU the workload needs.
+	RequiredVCPU float64
+
+	// Worker memory the workload needs, in GB.
+	RequiredMemoryGB float64
+}
+
+// ScalePrestoEngineOptions : Return the ScalePrestoEngine options that scale an engine to the recommended size.
+func (recommendation *EngineSizingRecommendation) ScalePrestoEngineOptions(engineID string) *ScalePrestoEngineOptions {
+	return &ScalePrestoEngineOptions{
+		EngineID: core.StringPtr(engineID),
+		Coordinator: &NodeDescription{
+			NodeType: recommendation.Coordinator.NodeType,
+			Quantity: recommendation.Coordinator.Quantity,
+		},
+		Worker: &NodeDescription{
+			NodeType: recommendation.Worker.NodeType,
+			Quantity: recommendation.Worker.Quantity,
+		},
+	}
+}
+
+// ScalePrestissimoEngineOptions : Return the ScalePrestissimoEngine options that scale an engine to the recommended size.
+func (recommendation *EngineSizingRecommendation) ScalePrestissimoEngineOptions(engineID string) *ScalePrestissimoEngineOptions {
+	return &ScalePrestissimoEngineOptions{
+		EngineID: core.StringPtr(engineID),
+		Coordinator: &PrestissimoNodeDescriptionBody{
+			NodeType: recommendation.Coordinator.NodeType,
+			Quantity: recommendation.Coordinator.Quantity,
+		},
+		Worker: &PrestissimoNodeDescriptionBody{
+			NodeType: recommendation.Worker.NodeType,
+			Quantity: recommendation.Worker.Quantity,
+		},
+	}
+}
+
+// EngineSizingModel : Maps engine sizes to capacity and suggests a size for a workload
+// The worker vCPU a workload needs is Concurrency * VCPUPerQuery. The worker memory it needs is
+// Concurrency * MemoryPerQueryGB + DataVolumeGB * WorkingSetRatio. The suggested size is the smallest size in Sizes
+// whose workers provide both.
+type EngineSizingModel struct {
+	// Predefined sizes.
+	Sizes []EngineSize
+
+	// Capacity of node types that cannot be derived from the node type name.
+	NodeTypes map[string]NodeTypeCapacity
+
+	// Worker vCPU needed per running query.
+	VCPUPerQuery float64
+
+	// Worker memory needed per running query, in GB.
+	MemoryPerQueryGB float64
+
+	// Share of the scanned data volume held in worker memory.
+	WorkingSetRatio float64
+}
+
+// NewEngineSizingModel : Instantiate an EngineSizingModel with the given sizes and node type capacities
+// The node types and counts of each size depend on the deployment, so they are supplied by the caller, for example
+// with NewEngineSize from engines of each size. Node types the service names by role or size, such as "starter" or
+// "worker", need an entry in nodeTypes; VPC profile names such as "bx2.16x64" do not. Each query is assumed to need
+// 4 vCPU and 8 GB, plus a tenth of the data it scans; tune VCPUPerQuery, MemoryPerQueryGB and WorkingSetRatio to
+// the workload.
+func NewEngineSizingModel(sizes []EngineSize, nodeTypes map[string]NodeTypeCapacity) *EngineSizingModel {
+	if nodeTypes == nil {
+		nodeTypes = map[string]NodeTypeCapacity{}
+	}
+	return &EngineSizingModel{
+		Sizes:            sizes,
+		NodeTypes:        nodeTypes,
+		VCPUPerQuery:     4,
+		MemoryPerQueryGB: 8,
+		WorkingSetRatio:  0.1,
+	}
+}
+
+// NewEngineSize : Instantiate an EngineSize from the coordinator and worker nodes of an engine, such as the
+// Coordinator and Worker of a PrestoEngine.
+func NewEngineSize(sizeConfig string, coordinator *NodeDescription, worker *NodeDescription) EngineSize {
+	size := EngineSize{SizeConfig: sizeConfig}
+	if coordinator != nil {
+		size.Coordinator = *copyNodeDescriptionBody(&NodeDescriptionBody{NodeType: coordinator.NodeType, Quantity: coordinator.Quantity})
+	}
+	if worker != nil {
+		size.Worker = *copyNodeDescriptionBody(&NodeDescriptionBody{NodeType: worker.NodeType, Quantity: worker.Quantity})
+	}
+	return size
+}
+
+// nodeTypeProfilePattern matches VPC profile names such as "bx2.16x64" or "bx2d.16x64".
+var nodeTypeProfilePattern = regexp.MustCompile(`^[a-z0-9]+\.(\d+)x(\d+)$`)
+
+// NodeTypeCapacity : Return the vCPU and memory of a node type
+// Node types listed in NodeTypes are looked up there; otherwise the capacity is read from a VPC profile name of
+// the form family.<vcpu>x<memory>. Service node type names such as "starter" or "worker" say nothing about
+// capacity, so they must be listed in NodeTypes.
+func (model *EngineSizingModel) NodeTypeCapacity(nodeType string) (capacity NodeTypeCapacity, err error) {
+	if known, ok := model.NodeTypes[nodeType]; ok {
+		return known, nil
+	}
+	match := nodeTypeProfilePattern.FindStringSubmatch(nodeType)
+	if match == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unknown node type '%s': add its capacity to NodeTypes", nodeType), "unknown-node-type", common.GetComponentInfo())
+		return
+	}
+	capacity.VCPU, _ = strconv.ParseInt(match[1], 10, 64)
+	capacity.MemoryGB, _ = strconv.ParseInt(match[2], 10, 64)
+	return
+}
+
+// Capacity : Return the capacity of an engine with the given coordinator and worker nodes.
+func (model *EngineSizingModel) Capacity(coordinator *NodeDescriptionBody, worker *NodeDescriptionBody) (capacity *EngineCapacity, err error) {
+	capacity = new(EngineCapacity)
+	for _, node := range []*NodeDescriptionBody{coordinator, worker} {
+		if node == nil || node.Quantity == nil || *node.Quantity == 0 {
+			continue
+		}
+		if node.NodeType == nil {
+			err = core.SDKErrorf(nil, "node type is required when quantity is set", "unknown-node-type", common.GetComponentInfo())
+			return nil, err
+		}
+		var nodeCapacity NodeTypeCapacity
+		if nodeCapacity, err = model.NodeTypeCapacity(*node.NodeType); err != nil {
+			return nil, err
+		}
+		quantity := *node.Quantity
+		capacity.Nodes += quantity
+		capacity.VCPU += quantity * nodeCapacity.VCPU
+		capacity.MemoryGB += quantity * nodeCapacity.MemoryGB
+		if node == worker {
+			capacity.WorkerNodes = quantity
+			capacity.WorkerVCPU = quantity * nodeCapacity.VCPU
+			capacity.WorkerMemoryGB = quantity * nodeCapacity.MemoryGB
+		}
+	}
+	return
+}
+
+// SizeCapacity : Return the capacity of a predefined size config.
+func (model *EngineSizingModel) SizeCapacity(sizeConfig string) (capacity *EngineCapacity, err error) {
+	for i := range model.Sizes {
+		if model.Sizes[i].SizeConfig == sizeConfig {
+			return model.Capacity(&model.Sizes[i].Coordinator, &model.Sizes[i].Worker)
+		}
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("unknown size config '%s'", sizeConfig), "unknown-size-config", common.GetComponentInfo())
+	return
+}
+
+// Suggest : Suggest an engine size for a workload
+// The smallest predefined size, by worker vCPU and then worker memory, that meets the requirements is returned. If
+// none does, a custom size is returned that keeps the coordinator of the largest size and adds as many of its
+// worker nodes as needed.
+func (model *EngineSizingModel) Suggest(requirements *EngineSizingRequirements) (recommendation *EngineSizingRecommendation, err error) {
+	err = core.ValidateNotNil(requirements, "requirements cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if requirements.Concurrency < 0 || requirements.DataVolumeGB < 0 {
+		err = core.SDKErrorf(nil, "concurrency and data volume must not be negative", "invalid-requirements", common.GetComponentInfo())
+		return
+	}
+	if len(model.Sizes) == 0 {
+		err = core.SDKErrorf(nil, "the sizing model has no sizes", "no-sizes", common.GetComponentInfo())
+		return
+	}
+	if err = model.Validate(); err != nil {
+		return
+	}
+
+	type candidate struct {
+		size     *EngineSize
+		capacity *EngineCapacity
+	}
+	candidates := make([]candidate, len(model.Sizes))
+	for i := range model.Sizes {
+		candidates[i].size = &model.Sizes[i]
+		if candidates[i].capacity, err = model.Capacity(&model.Sizes[i].Coordinator, &model.Sizes[i].Worker); err != nil {
+			return
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].capacity.WorkerVCPU != candidates[j].capacity.WorkerVCPU {
+			return candidates[i].capacity.WorkerVCPU < candidates[j].capacity.WorkerVCPU
+		}
+		return candidates[i].capacity.WorkerMemoryGB < candidates[j].capacity.WorkerMemoryGB
+	})
+
+	requiredVCPU := float64(requirements.Concurrency) * model.VCPUPerQuery
+	requiredMemoryGB := float64(requirements.Concurrency)*model.MemoryPerQueryGB + requirements.DataVolumeGB*model.WorkingSetRatio
+	recommendation = &EngineSizingRecommendation{
+		RequiredVCPU:     requiredVCPU,
+		RequiredMemoryGB: requiredMemoryGB,
+	}
+	for _, c := range candidates {
+		if float64(c.capacity.WorkerVCPU) >= requiredVCPU && float64(c.capacity.WorkerMemoryGB) >= requiredMemoryGB {
+			recommendation.SizeConfig = c.size.SizeConfig
+			recommendation.Coordinator = copyNodeDescriptionBody(&c.size.Coordinator)
+			recommendation.Worker = copyNodeDescriptionBody(&c.size.Worker)
+			recommendation.Capacity = c.capacity
+			return
+		}
+	}
+
+	largest := candidates[len(candidates)-1].size
+	workerCapacity, err := model.NodeTypeCapacity(*largest.Worker.NodeType)
+	if err != nil {
+		return nil, err
+	}
+	workers := int64(math.Max(
+		math.Ceil(requiredVCPU/float64(workerCapacity.VCPU)),
+		math.Ceil(requiredMemoryGB/float64(workerCapacity.MemoryGB)),
+	))
+	recommendation.SizeConfig = EngineDetailsBody_SizeConfig_Custom
+	recommendation.Coordinator = copyNodeDescriptionBody(&largest.Coordinator)
+	recommendation.Worker = &NodeDescriptionBody{
+		NodeType: core.StringPtr(*largest.Worker.NodeType),
+		Quantity: core.Int64Ptr(workers),
+	}
+	recommendation.Capacity, err = model.Capacity(recommendation.Coordinator, recommendation.Worker)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Validate : Check that the sizing model can be used by Suggest
+// Every size needs a worker node type, and every node type a size uses needs a known, positive vCPU and memory
+// capacity. The per-query needs and the working set ratio must not be negative. All problems are reported in one
+// error.
+func (model *EngineSizingModel) Validate() error {
+	var problems []string
+	if model.VCPUPerQuery < 0 || model.MemoryPerQueryGB < 0 || model.WorkingSetRatio < 0 {
+		problems = append(problems, "per-query needs and working set ratio must not be negative")
+	}
+	for _, size := range model.Sizes {
+		for _, node := range []struct {
+			role string
+			body NodeDescriptionBody
+		}{
+			{"coordinator", size.Coordinator},
+			{"worker", size.Worker},
+		} {
+			if node.body.NodeType == nil || *node.body.NodeType == "" {
+				if node.role == "worker" || (node.body.Quantity != nil && *node.body.Quantity > 0) {
+					problems = append(problems, fmt.Sprintf("size '%s' has no %s node type", size.SizeConfig, node.role))
+				}
+				continue
+			}
+			capacity, err := model.NodeTypeCapacity(*node.body.NodeType)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("size '%s' has unknown %s node type '%s'", size.SizeConfig, node.role, *node.body.NodeType))
+			} else if capacity.VCPU <= 0 || capacity.MemoryGB <= 0 {
+				problems = append(problems, fmt.Sprintf("size '%s' %s node type '%s' has no vCPU or memory", size.SizeConfig, node.role, *node.body.NodeType))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return core.SDKErrorf(nil, "invalid sizing model: "+strings.Join(problems, "; "), "invalid-sizing-model", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// copyNodeDescriptionBody returns a copy of node that shares no pointers with it.
+func copyNodeDescriptionBody(node *NodeDescriptionBody) *NodeDescriptionBody {
+	copied := new(NodeDescriptionBody)
+	if node.NodeType != nil {
+		copied.NodeType = core.StringPtr(*node.NodeType)
+	}
+	if node.Quantity != nil {
+		copied.Quantity = core.Int64Ptr(*node.Quantity)
+	}
+	return copied
+}
@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 engine sizing`, func() {
+	// newEngineSizingModel returns a model with sizes made of VPC profiles, as a deployment would describe them.
+	newEngineSizingModel := func() *watsonxdatav2.EngineSizingModel {
+		size := func(sizeConfig string, coordinatorType string, workerType string, workers int64) watsonxdatav2.EngineSize {
+			return watsonxdatav2.NewEngineSize(sizeConfig,
+				&watsonxdatav2.NodeDescription{NodeType: core.StringPtr(coordinatorType), Quantity: core.Int64Ptr(1)},
+				&watsonxdatav2.NodeDescription{NodeType: core.StringPtr(workerType), Quantity: core.Int64Ptr(workers)})
+		}
+		return watsonxdatav2.NewEngineSizingModel([]watsonxdatav2.EngineSize{
+			size(watsonxdatav2.EngineDetailsBody_SizeConfig_Starter, "bx2.4x16", "bx2.4x16", 1),
+			size(watsonxdatav2.EngineDetailsBody_SizeConfig_Small, "bx2.16x64", "bx2.16x64", 3),
+			size(watsonxdatav2.EngineDetailsBody_SizeConfig_Medium, "bx2.16x64", "bx2.16x64", 6),
+			size(watsonxdatav2.EngineDetailsBody_SizeConfig_Large, "bx2.16x64", "bx2.16x64", 12),
+		}, nil)
+	}
+	Describe(`EngineSizingModel`, func() {
+		It(`Map node types and sizes to capacity`, func() {
+			model := newEngineSizingModel()
+			capacity, err := model.NodeTypeCapacity("bx2d.16x64")
+			Expect(err).To(BeNil())
+			Expect(capacity).To(Equal(watsonxdatav2.NodeTypeCapacity{VCPU: 16, MemoryGB: 64}))
+
+			_, err = model.NodeTypeCapacity("worker")
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("unknown node type 'worker': add its capacity to NodeTypes"))
+			model.NodeTypes["worker"] = watsonxdatav2.NodeTypeCapacity{VCPU: 8, MemoryGB: 32}
+			capacity, err = model.NodeTypeCapacity("worker")
+			Expect(err).To(BeNil())
+			Expect(capacity.VCPU).To(Equal(int64(8)))
+
+			engineCapacity, err := model.SizeCapacity(watsonxdatav2.EngineDetailsBody_SizeConfig_Small)
+			Expect(err).To(BeNil())
+			Expect(*engineCapacity).To(Equal(watsonxdatav2.EngineCapacity{
+				Nodes:          4,
+				VCPU:           64,
+				MemoryGB:       256,
+				WorkerNodes:    3,
+				WorkerVCPU:     48,
+				WorkerMemoryGB: 192,
+			}))
+			_, err = model.SizeCapacity("xxlarge")
+			Expect(err).ToNot(BeNil())
+
+			engineCapacity, err = model.Capacity(nil, &watsonxdatav2.NodeDescriptionBody{NodeType: core.StringPtr("worker"), Quantity: core.Int64Ptr(2)})
+			Expect(err).To(BeNil())
+			Expect(engineCapacity.WorkerMemoryGB).To(Equal(int64(64)))
+		})
+		It(`Suggest the smallest size that fits`, func() {
+			model := newEngineSizingModel()
+
+			// Invoke operation with nil requirements (negative test)
+			recommendation, err := model.Suggest(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(recommendation).To(BeNil())
+
+			recommendation, err = model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: 1})
+			Expect(err).To(BeNil())
+			Expect(recommendation.SizeConfig).To(Equal(watsonxdatav2.EngineDetailsBody_SizeConfig_Starter))
+
+			recommendation, err = model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: 10, DataVolumeGB: 500})
+			Expect(err).To(BeNil())
+			Expect(recommendation.RequiredVCPU).To(Equal(float64(40)))
+			Expect(recommendation.RequiredMemoryGB).To(Equal(float64(130)))
+			Expect(recommendation.SizeConfig).To(Equal(watsonxdatav2.EngineDetailsBody_SizeConfig_Small))
+			Expect(*recommendation.Worker.Quantity).To(Equal(int64(3)))
+
+			recommendation, err = model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: 20})
+			Expect(err).To(BeNil())
+			Expect(recommendation.SizeConfig).To(Equal(watsonxdatav2.EngineDetailsBody_SizeConfig_Medium))
+
+			_, err = model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: -1})
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Suggest a custom size beyond the largest size`, func() {
+			model := newEngineSizingModel()
+			recommendation, err := model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: 100, DataVolumeGB: 10000})
+			Expect(err).To(BeNil())
+			Expect(recommendation.SizeConfig).To(Equal(watsonxdatav2.EngineDetailsBody_SizeConfig_Custom))
+			Expect(*recommendation.Worker.NodeType).To(Equal("bx2.16x64"))
+			Expect(*recommendation.Worker.Quantity).To(Equal(int64(29)))
+			Expect(recommendation.Capacity.WorkerVCPU).To(BeNumerically(">=", recommendation.RequiredVCPU))
+
+			scalePrestoEngineOptions := recommendation.ScalePrestoEngineOptions("presto01")
+			Expect(*scalePrestoEngineOptions.EngineID).To(Equal("presto01"))
+			Expect(*scalePrestoEngineOptions.Worker.Quantity).To(Equal(int64(29)))
+			Expect(*scalePrestoEngineOptions.Coordinator.NodeType).To(Equal("bx2.16x64"))
+
+			scalePrestissimoEngineOptions := recommendation.ScalePrestissimoEngineOptions("prestissimo01")
+			Expect(*scalePrestissimoEngineOptions.EngineID).To(Equal("prestissimo01"))
+			Expect(*scalePrestissimoEngineOptions.Worker.Quantity).To(Equal(int64(29)))
+
+			// The recommendation does not share pointers with the model
+			*recommendation.Coordinator.Quantity = 5
+			Expect(*model.Sizes[len(model.Sizes)-1].Coordinator.Quantity).To(Equal(int64(1)))
+		})
+		It(`Size engines that use service node types`, func() {
+			var engine watsonxdatav2.PrestoEngine
+			Expect(json.Unmarshal([]byte(`{"size_config": "starter", "coordinator": {"node_type": "starter", "quantity": 1}, "worker": {"node_type": "worker", "quantity": 2}}`), &engine)).To(Succeed())
+			model := watsonxdatav2.NewEngineSizingModel([]watsonxdatav2.EngineSize{
+				watsonxdatav2.NewEngineSize(*engine.SizeConfig, engine.Coordinator, engine.Worker),
+			}, nil)
+			_, err := model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: 1})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("size 'starter' has unknown coordinator node type 'starter'"))
+			Expect(err.Error()).To(ContainSubstring("size 'starter' has unknown worker node type 'worker'"))
+
+			model.NodeTypes["starter"] = watsonxdatav2.NodeTypeCapacity{VCPU: 4, MemoryGB: 16}
+			model.NodeTypes["worker"] = watsonxdatav2.NodeTypeCapacity{VCPU: 8, MemoryGB: 32}
+			recommendation, err := model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: 4})
+			Expect(err).To(BeNil())
+			Expect(recommendation.SizeConfig).To(Equal(watsonxdatav2.EngineDetailsBody_SizeConfig_Starter))
+			Expect(*recommendation.Capacity).To(Equal(watsonxdatav2.EngineCapacity{
+				Nodes:          3,
+				VCPU:           20,
+				MemoryGB:       80,
+				WorkerNodes:    2,
+				WorkerVCPU:     16,
+				WorkerMemoryGB: 64,
+			}))
+
+			// The size does not share pointers with the engine
+			*engine.Worker.Quantity = 5
+			Expect(*model.Sizes[0].Worker.Quantity).To(Equal(int64(2)))
+		})
+		It(`Reject an invalid sizing model`, func() {
+			model := newEngineSizingModel()
+			Expect(model.Validate()).To(Succeed())
+
+			model.Sizes = append(model.Sizes, watsonxdatav2.EngineSize{
+				SizeConfig: "huge",
+				Coordinator: watsonxdatav2.NodeDescriptionBody{
+					NodeType: core.StringPtr("bx2.16x64"),
+					Quantity: core.Int64Ptr(1),
+				},
+				Worker: watsonxdatav2.NodeDescriptionBody{
+					Quantity: core.Int64Ptr(0),
+				},
+			})
+			_, err := model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: 100})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("size 'huge' has no worker node type"))
+
+			model.Sizes[len(model.Sizes)-1].Worker.NodeType = core.StringPtr("gpu.large")
+			model.NodeTypes["gpu.large"] = watsonxdatav2.NodeTypeCapacity{MemoryGB: 64}
+			_, err = model.Suggest(&watsonxdatav2.EngineSizingRequirements{Concurrency: 100})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("node type 'gpu.large' has no vCPU or memory"))
+		})
+	})
+})
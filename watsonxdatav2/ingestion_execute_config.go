@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// Constants associated with the ingestion execute config presets.
+const (
+	IngestionExecuteConfig_Preset_Large  = "large"
+	IngestionExecuteConfig_Preset_Medium = "medium"
+	IngestionExecuteConfig_Preset_Small  = "small"
+)
+
+// Input sizes up to which the small and medium presets are suggested, in bytes.
+const (
+	ingestionSmallPresetMaxBytes  = 1 << 30
+	ingestionMediumPresetMaxBytes = 10 << 30
+)
+
+// sparkMemory matches a Spark memory string such as "512m" or "4G".
+var sparkMemory = regexp.MustCompile(`^(?i)(\d+)\s*([kmgt])i?b?$`)
+
+// sparkMemoryUnits are the sizes of the Spark memory units, in bytes.
+var sparkMemoryUnits = map[string]int64{
+	"k": 1 << 10,
+	"m": 1 << 20,
+	"g": 1 << 30,
+	"t": 1 << 40,
+}
+
+// ingestionExecuteConfigPresets are the driver and executor sizes of each preset.
+var ingestionExecuteConfigPresets = map[string]IngestionJobPrototypeExecuteConfig{
+	IngestionExecuteConfig_Preset_Small: {
+		DriverCores:    core.Int64Ptr(1),
+		DriverMemory:   core.StringPtr("2G"),
+		ExecutorCores:  core.Int64Ptr(1),
+		ExecutorMemory: core.StringPtr("2G"),
+		NumExecutors:   core.Int64Ptr(1),
+	},
+	IngestionExecuteConfig_Preset_Medium: {
+		DriverCores:    core.Int64Ptr(2),
+		DriverMemory:   core.StringPtr("4G"),
+		ExecutorCores:  core.Int64Ptr(2),
+		ExecutorMemory: core.StringPtr("4G"),
+		NumExecutors:   core.Int64Ptr(2),
+	},
+	IngestionExecuteConfig_Preset_Large: {
+		DriverCores:    core.Int64Ptr(4),
+		DriverMemory:   core.StringPtr("8G"),
+		ExecutorCores:  core.Int64Ptr(4),
+		ExecutorMemory: core.StringPtr("8G"),
+		NumExecutors:   core.Int64Ptr(4),
+	},
+}
+
+// IngestionExecuteConfigPresetForSize : Return the preset suggested for an input of the given size in bytes
+// Inputs up to 1 GiB get the small preset, up to 10 GiB the medium preset and larger inputs the large preset.
+func IngestionExecuteConfigPresetForSize(size int64) string {
+	switch {
+	case size <= ingestionSmallPresetMaxBytes:
+		return IngestionExecuteConfig_Preset_Small
+	case size <= ingestionMediumPresetMaxBytes:
+		return IngestionExecuteConfig_Preset_Medium
+	}
+	return IngestionExecuteConfig_Preset_Large
+}
+
+// NewIngestionExecuteConfig : Return a copy of the execute config of a preset.
+func NewIngestionExecuteConfig(preset string) (*IngestionJobPrototypeExecuteConfig, error) {
+	config, ok := ingestionExecuteConfigPresets[preset]
+	if !ok {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("unknown execute config preset '%s'", preset), "unknown-execute-config-preset", common.GetComponentInfo())
+	}
+	return &IngestionJobPrototypeExecuteConfig{
+		DriverCores:    core.Int64Ptr(*config.DriverCores),
+		DriverMemory:   core.StringPtr(*config.DriverMemory),
+		ExecutorCores:  core.Int64Ptr(*config.ExecutorCores),
+		ExecutorMemory: core.StringPtr(*config.ExecutorMemory),
+		NumExecutors:   core.Int64Ptr(*config.NumExecutors),
+	}, nil
+}
+
+// IngestionExecuteConfigForSize : Return the execute config of the preset suggested for an input of the given size.
+func IngestionExecuteConfigForSize(size int64) *IngestionJobPrototypeExecuteConfig {
+	config, _ := NewIngestionExecuteConfig(IngestionExecuteConfigPresetForSize(size))
+	return config
+}
+
+// ParseSparkMemory : Return the size of a Spark memory string in bytes
+// The string is a whole number followed by a unit of k, m, g or t, in either case and optionally followed by "b"
+// or "ib", for example "512m" or "4G". A unit is required because Spark reads bare numbers in a setting-specific
+// unit.
+func ParseSparkMemory(memory string) (int64, error) {
+	match := sparkMemory.FindStringSubmatch(strings.TrimSpace(memory))
+	if match == nil {
+		return 0, core.SDKErrorf(nil, fmt.Sprintf("memory '%s' is not a number followed by k, m, g or t", memory), "invalid-spark-memory", common.GetComponentInfo())
+	}
+	value, err := strconv.ParseInt(match[1], 10, 64)
+	unit := sparkMemoryUnits[strings.ToLower(match[2])]
+	if err != nil || value == 0 || value > math.MaxInt64/unit {
+		return 0, core.SDKErrorf(err, fmt.Sprintf("memory '%s' is out of range", memory), "invalid-spark-memory", common.GetComponentInfo())
+	}
+	return value * unit, nil
+}
+
+// ValidateIngestionExecuteConfig : Check an execute config for problems
+// Cores and executor counts must be positive and memory strings valid (see ParseSparkMemory). If limit is not nil
+// the cores and memory of the driver and all executors together must fit in it. A limit memory without unit is in
+// GiB. Fields that are not set are left to the service defaults and are not counted. All problems are reported in
+// one error.
+func ValidateIngestionExecuteConfig(config *IngestionJobPrototypeExecuteConfig, limit *SparkEngineResourceLimit) error {
+	if config == nil {
+		return core.SDKErrorf(nil, "the execute config is nil", "invalid-execute-config", common.GetComponentInfo())
+	}
+	var problems []string
+	positive := func(name string, value *int64) int64 {
+		if value == nil {
+			return 0
+		}
+		if *value <= 0 {
+			problems = append(problems, fmt.Sprintf("%s must be positive", name))
+			return 0
+		}
+		return *value
+	}
+	memory := func(name string, value *string) int64 {
+		if value == nil {
+			return 0
+		}
+		bytes, err := ParseSparkMemory(*value)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s '%s' is not a valid memory size", name, *value))
+		}
+		return bytes
+	}
+	driverCores := positive("driver_cores", config.DriverCores)
+	executorCores := positive("executor_cores", config.ExecutorCores)
+	executors := positive("num_executors", config.NumExecutors)
+	driverMemory := memory("driver_memory", config.DriverMemory)
+	executorMemory := memory("executor_memory", config.ExecutorMemory)
+	if executors == 0 {
+		executors = 1
+	}
+
+	if limit != nil {
+		// Totals are computed as floats, which cannot overflow, and compared by division where they must be exact.
+		totalCores := float64(driverCores) + float64(executorCores)*float64(executors)
+		if limit.Cores != nil {
+			limitCores, err := strconv.ParseFloat(strings.TrimSpace(*limit.Cores), 64)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("resource limit cores '%s' is not a number", *limit.Cores))
+			} else if totalCores > limitCores {
+				problems = append(problems, fmt.Sprintf("%.0f cores requested but the engine limit is %s", totalCores, *limit.Cores))
+			}
+		}
+		if limit.Memory != nil {
+			limitMemory := strings.TrimSpace(*limit.Memory)
+			if _, err := strconv.ParseInt(limitMemory, 10, 64); err == nil {
+				limitMemory += "G"
+			}
+			limitBytes, err := ParseSparkMemory(limitMemory)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("resource limit memory '%s' is not a valid memory size", *limit.Memory))
+			} else if driverMemory > limitBytes || executorMemory > (limitBytes-driverMemory)/executors {
+				totalMemory := float64(driverMemory) + float64(executorMemory)*float64(executors)
+				problems = append(problems, fmt.Sprintf("%.1f GiB memory requested but the engine limit is %s", totalMemory/(1<<30), *limit.Memory))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return core.SDKErrorf(nil, "invalid execute config: "+strings.Join(problems, "; "), "invalid-execute-config", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// EncodeLocalFilesExecuteConfig : Return an execute config in the base64 encoded form of a local files ingestion job.
+func EncodeLocalFilesExecuteConfig(config *IngestionJobPrototypeExecuteConfig) (string, error) {
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "execute-config-json-error", common.GetComponentInfo())
+	}
+	return base64.StdEncoding.EncodeToString(encoded), nil
+}
@@ -0,0 +1,112 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"encoding/base64"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 ingestion execute config`, func() {
+	Describe(`NewIngestionExecuteConfig(preset string)`, func() {
+		It(`Size presets to the input`, func() {
+			Expect(watsonxdatav2.IngestionExecuteConfigPresetForSize(200 << 20)).To(Equal("small"))
+			Expect(watsonxdatav2.IngestionExecuteConfigPresetForSize(5 << 30)).To(Equal("medium"))
+			Expect(watsonxdatav2.IngestionExecuteConfigPresetForSize(50 << 30)).To(Equal("large"))
+
+			config := watsonxdatav2.IngestionExecuteConfigForSize(5 << 30)
+			Expect(config).To(Equal(&watsonxdatav2.IngestionJobPrototypeExecuteConfig{
+				DriverCores:    core.Int64Ptr(2),
+				DriverMemory:   core.StringPtr("4G"),
+				ExecutorCores:  core.Int64Ptr(2),
+				ExecutorMemory: core.StringPtr("4G"),
+				NumExecutors:   core.Int64Ptr(2),
+			}))
+			config.NumExecutors = core.Int64Ptr(8)
+			Expect(*watsonxdatav2.IngestionExecuteConfigForSize(5 << 30).NumExecutors).To(Equal(int64(2)))
+
+			_, err := watsonxdatav2.NewIngestionExecuteConfig("huge")
+			Expect(err).ToNot(BeNil())
+
+			encoded, err := watsonxdatav2.EncodeLocalFilesExecuteConfig(watsonxdatav2.IngestionExecuteConfigForSize(1))
+			Expect(err).To(BeNil())
+			decoded, err := base64.StdEncoding.DecodeString(encoded)
+			Expect(err).To(BeNil())
+			Expect(string(decoded)).To(Equal(`{"driver_cores":1,"driver_memory":"2G","executor_cores":1,"executor_memory":"2G","num_executors":1}`))
+		})
+	})
+	Describe(`ValidateIngestionExecuteConfig(config *IngestionJobPrototypeExecuteConfig, limit *SparkEngineResourceLimit)`, func() {
+		It(`Parse memory strings`, func() {
+			for memory, bytes := range map[string]int64{"512m": 512 << 20, "4G": 4 << 30, "2gb": 2 << 30, "1Ti": 1 << 40} {
+				Expect(watsonxdatav2.ParseSparkMemory(memory)).To(Equal(bytes))
+			}
+			for _, memory := range []string{"4", "4GG", "0g", "-1g", "1.5g", ""} {
+				_, err := watsonxdatav2.ParseSparkMemory(memory)
+				Expect(err).ToNot(BeNil(), memory)
+			}
+		})
+		It(`Check configs against resource limits`, func() {
+			limit := &watsonxdatav2.SparkEngineResourceLimit{
+				Cores:  core.StringPtr("8"),
+				Memory: core.StringPtr("16"),
+			}
+			small, _ := watsonxdatav2.NewIngestionExecuteConfig("small")
+			Expect(watsonxdatav2.ValidateIngestionExecuteConfig(small, limit)).To(Succeed())
+			Expect(watsonxdatav2.ValidateIngestionExecuteConfig(small, nil)).To(Succeed())
+
+			large, _ := watsonxdatav2.NewIngestionExecuteConfig("large")
+			err := watsonxdatav2.ValidateIngestionExecuteConfig(large, limit)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("20 cores requested but the engine limit is 8"))
+			Expect(err.Error()).To(ContainSubstring("40.0 GiB memory requested but the engine limit is 16"))
+
+			err = watsonxdatav2.ValidateIngestionExecuteConfig(&watsonxdatav2.IngestionJobPrototypeExecuteConfig{
+				DriverMemory: core.StringPtr("4"),
+				NumExecutors: core.Int64Ptr(0),
+			}, &watsonxdatav2.SparkEngineResourceLimit{
+				Memory: core.StringPtr("lots"),
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("num_executors must be positive"))
+			Expect(err.Error()).To(ContainSubstring("driver_memory '4' is not a valid memory size"))
+			Expect(err.Error()).To(ContainSubstring("resource limit memory 'lots'"))
+
+			// Totals that overflow int64 still exceed the limit, and a config that exactly fits it passes
+			err = watsonxdatav2.ValidateIngestionExecuteConfig(&watsonxdatav2.IngestionJobPrototypeExecuteConfig{
+				ExecutorCores:  core.Int64Ptr(1 << 62),
+				ExecutorMemory: core.StringPtr("4t"),
+				NumExecutors:   core.Int64Ptr(1 << 30),
+			}, limit)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("cores requested but the engine limit is 8"))
+			Expect(err.Error()).To(ContainSubstring("GiB memory requested but the engine limit is 16"))
+			Expect(watsonxdatav2.ValidateIngestionExecuteConfig(&watsonxdatav2.IngestionJobPrototypeExecuteConfig{
+				DriverCores:    core.Int64Ptr(2),
+				DriverMemory:   core.StringPtr("4g"),
+				ExecutorCores:  core.Int64Ptr(2),
+				ExecutorMemory: core.StringPtr("4g"),
+				NumExecutors:   core.Int64Ptr(3),
+			}, limit)).To(Succeed())
+
+			Expect(watsonxdatav2.ValidateIngestionExecuteConfig(nil, limit)).ToNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,276 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// defaultIngestionFingerprintsFile is the name of the default fingerprint store file.
+const defaultIngestionFingerprintsFile = "ingestion-fingerprints.json"
+
+// IngestionFingerprintStore : Records which job ingested each source fingerprint into a target table.
+// Implementations must be safe for concurrent use.
+type IngestionFingerprintStore interface {
+	// Lookup returns the job ID recorded for a fingerprint in a target table.
+	Lookup(targetTable string, fingerprint string) (jobID string, found bool, err error)
+
+	// Record stores the job ID that ingested a fingerprint into a target table.
+	Record(targetTable string, fingerprint string, jobID string) error
+}
+
+// LocalIngestionFingerprintStore : An IngestionFingerprintStore kept in a local JSON file.
+// The file maps target tables to fingerprints to job IDs. It is read on every lookup and replaced atomically on
+// every record, so several processes can share it as long as they do not record at the same time.
+type LocalIngestionFingerprintStore struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewLocalIngestionFingerprintStore : Instantiate LocalIngestionFingerprintStore
+// The file and its directory are created on the first record.
+func NewLocalIngestionFingerprintStore(path string) *LocalIngestionFingerprintStore {
+	return &LocalIngestionFingerprintStore{
+		path: path,
+	}
+}
+
+// DefaultIngestionFingerprintStorePath : Return the path of the default fingerprint store
+// This is watsonxdata/ingestion-fingerprints.json in the user configuration directory, or in the working directory
+// if there is none.
+func DefaultIngestionFingerprintStorePath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return defaultIngestionFingerprintsFile
+	}
+	return filepath.Join(dir, "watsonxdata", defaultIngestionFingerprintsFile)
+}
+
+// Lookup returns the job ID recorded for a fingerprint in a target table.
+func (store *LocalIngestionFingerprintStore) Lookup(targetTable string, fingerprint string) (jobID string, found bool, err error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	fingerprints, err := store.read()
+	if err != nil {
+		return
+	}
+	jobID, found = fingerprints[targetTable][fingerprint]
+	return
+}
+
+// Record stores the job ID that ingested a fingerprint into a target table.
+func (store *LocalIngestionFingerprintStore) Record(targetTable string, fingerprint string, jobID string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	fingerprints, err := store.read()
+	if err != nil {
+		return err
+	}
+	if fingerprints[targetTable] == nil {
+		fingerprints[targetTable] = map[string]string{}
+	}
+	fingerprints[targetTable][fingerprint] = jobID
+
+	if err = writeJSONFile(store.path, fingerprints); err != nil {
+		return core.SDKErrorf(err, "", "fingerprint-store-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// read returns the contents of the store file, or an empty map if it does not exist.
+func (store *LocalIngestionFingerprintStore) read() (fingerprints map[string]map[string]string, err error) {
+	fingerprints = map[string]map[string]string{}
+	if err = readJSONFile(store.path, &fingerprints); err != nil {
+		err = core.SDKErrorf(err, "", "fingerprint-store-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// FingerprintLocalFile : Return the fingerprint of a local file: "sha256:" and the hex SHA-256 of its content.
+func FingerprintLocalFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "source-file-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", core.SDKErrorf(err, "", "source-file-error", common.GetComponentInfo())
+	}
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// FingerprintObjectPaths : Return the fingerprint of comma separated source file or directory paths
+// Object contents are not read, so the fingerprint is "paths:" and the hex SHA-256 of the sorted, trimmed paths.
+// The same paths in any order have the same fingerprint.
+func FingerprintObjectPaths(sourceDataFiles string) string {
+	var paths []string
+	for _, path := range strings.Split(sourceDataFiles, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	hash := sha256.Sum256([]byte(strings.Join(paths, "\n")))
+	return "paths:" + hex.EncodeToString(hash[:])
+}
+
+// ingestionJobHistory maps the IDs of the listed ingestion jobs to their statuses.
+type ingestionJobHistory map[string]string
+
+// listIngestionJobHistory lists every ingestion job with IngestionJobsPager.
+func (watsonxData *WatsonxDataV2) listIngestionJobHistory(ctx context.Context, authInstanceID *string, headers map[string]string) (history ingestionJobHistory, err error) {
+	pager, err := watsonxData.NewIngestionJobsPager(&ListIngestionJobsOptions{
+		AuthInstanceID: authInstanceID,
+		Headers:        headers,
+	})
+	if err != nil {
+		return
+	}
+	jobs, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-ingestion-jobs-error")
+		return
+	}
+	history = ingestionJobHistory{}
+	for _, job := range jobs {
+		if job.JobID != nil {
+			history[*job.JobID] = core.StringNilMapper(job.Status)
+		}
+	}
+	return
+}
+
+// ingested returns the job recorded in store for the fingerprint in the target table, and whether that job loaded
+// or is loading the source. A recorded job that failed does not count; a recorded job missing from the history is
+// trusted.
+func (history ingestionJobHistory) ingested(store IngestionFingerprintStore, targetTable string, fingerprint string) (jobID string, ingested bool, err error) {
+	jobID, found, err := store.Lookup(targetTable, fingerprint)
+	if err != nil || !found {
+		return
+	}
+	status, listed := history[jobID]
+	ingested = !listed || !containsFold(ingestionJobFailedStatuses, status)
+	return
+}
+
+// IngestionJobOnceResult : The outcome of CreateIngestionJobsOnce.
+type IngestionJobOnceResult struct {
+	// Fingerprint of the source paths.
+	Fingerprint string
+
+	// Created ingestion job, if the source had not been ingested.
+	Job *IngestionJob
+
+	// Job that already ingested the source, if the job was not created.
+	PreviousJobID string
+}
+
+// CreateIngestionJobsOnce : Create an ingestion job unless its source was already loaded into the target table
+// The source paths are fingerprinted with FingerprintObjectPaths. If the store has a job for the fingerprint and
+// target table, and ListIngestionJobs does not report that job as failed, no job is created. Otherwise the job is
+// created and recorded in the store.
+func (watsonxData *WatsonxDataV2) CreateIngestionJobsOnce(createIngestionJobsOnceOptions *CreateIngestionJobsOnceOptions) (result *IngestionJobOnceResult, err error) {
+	result, err = watsonxData.CreateIngestionJobsOnceWithContext(context.Background(), createIngestionJobsOnceOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateIngestionJobsOnceWithContext is an alternate form of the CreateIngestionJobsOnce method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) CreateIngestionJobsOnceWithContext(ctx context.Context, createIngestionJobsOnceOptions *CreateIngestionJobsOnceOptions) (result *IngestionJobOnceResult, err error) {
+	err = core.ValidateNotNil(createIngestionJobsOnceOptions, "createIngestionJobsOnceOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createIngestionJobsOnceOptions, "createIngestionJobsOnceOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	job := createIngestionJobsOnceOptions.CreateIngestionJobsOptions
+	store := createIngestionJobsOnceOptions.FingerprintStore
+	if store == nil {
+		store = NewLocalIngestionFingerprintStore(DefaultIngestionFingerprintStorePath())
+	}
+
+	history, err := watsonxData.listIngestionJobHistory(ctx, job.AuthInstanceID, job.Headers)
+	if err != nil {
+		return
+	}
+	fingerprint := FingerprintObjectPaths(*job.SourceDataFiles)
+	previousJobID, ingested, err := history.ingested(store, *job.TargetTable, fingerprint)
+	if err != nil {
+		return
+	}
+	result = &IngestionJobOnceResult{
+		Fingerprint: fingerprint,
+	}
+	if ingested {
+		result.PreviousJobID = previousJobID
+		return
+	}
+
+	result.Job, _, err = watsonxData.CreateIngestionJobsWithContext(ctx, job)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		result = nil
+		return
+	}
+	err = store.Record(*job.TargetTable, fingerprint, *job.JobID)
+	return
+}
+
+// CreateIngestionJobsOnceOptions : The CreateIngestionJobsOnce options.
+type CreateIngestionJobsOnceOptions struct {
+	// The job to create.
+	CreateIngestionJobsOptions *CreateIngestionJobsOptions `json:"create_ingestion_jobs_options" validate:"required"`
+
+	// Store of the ingested fingerprints. Defaults to a LocalIngestionFingerprintStore at
+	// DefaultIngestionFingerprintStorePath.
+	FingerprintStore IngestionFingerprintStore `json:"-"`
+}
+
+// NewCreateIngestionJobsOnceOptions : Instantiate CreateIngestionJobsOnceOptions
+func (*WatsonxDataV2) NewCreateIngestionJobsOnceOptions(createIngestionJobsOptions *CreateIngestionJobsOptions) *CreateIngestionJobsOnceOptions {
+	return &CreateIngestionJobsOnceOptions{
+		CreateIngestionJobsOptions: createIngestionJobsOptions,
+	}
+}
+
+// SetCreateIngestionJobsOptions : Allow user to set CreateIngestionJobsOptions
+func (_options *CreateIngestionJobsOnceOptions) SetCreateIngestionJobsOptions(createIngestionJobsOptions *CreateIngestionJobsOptions) *CreateIngestionJobsOnceOptions {
+	_options.CreateIngestionJobsOptions = createIngestionJobsOptions
+	return _options
+}
+
+// SetFingerprintStore : Allow user to set FingerprintStore
+func (_options *CreateIngestionJobsOnceOptions) SetFingerprintStore(fingerprintStore IngestionFingerprintStore) *CreateIngestionJobsOnceOptions {
+	_options.FingerprintStore = fingerprintStore
+	return _options
+}
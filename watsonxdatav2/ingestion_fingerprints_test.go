@@ -0,0 +1,250 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 ingestion fingerprints`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var created []string
+	var failUploads int
+	var dir string
+	BeforeEach(func() {
+		created = nil
+		failUploads = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/ingestion_jobs":
+				fmt.Fprint(res, `{"ingestion_jobs": [{"job_id": "job-c", "status": "completed"}, {"job_id": "job-d", "status": "failed"}]}`)
+			case req.Method == "POST" && req.URL.EscapedPath() == "/ingestion_jobs_local_files":
+				Expect(req.ParseMultipartForm(1 << 20)).To(Succeed())
+				mutex.Lock()
+				if failUploads > 0 {
+					failUploads--
+					mutex.Unlock()
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"message": "upload failed"}]}`)
+					return
+				}
+				created = append(created, req.FormValue("job_id"))
+				mutex.Unlock()
+				res.WriteHeader(202)
+				fmt.Fprintf(res, `{"job_id": "%s", "status": "running"}`, req.FormValue("job_id"))
+			case req.Method == "POST" && req.URL.EscapedPath() == "/ingestion_jobs":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				created = append(created, body["job_id"].(string))
+				res.WriteHeader(202)
+				fmt.Fprintf(res, `{"job_id": "%s", "status": "running"}`, body["job_id"])
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+
+		var err error
+		dir, err = os.MkdirTemp("", "fingerprints")
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(dir)
+	})
+	Describe(`IngestLocalFiles(ingestLocalFilesOptions *IngestLocalFilesOptions)`, func() {
+		It(`Skip files already ingested`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			source := filepath.Join(dir, "data")
+			Expect(os.Mkdir(source, 0700)).To(Succeed())
+			for name, content := range map[string]string{"a.csv": "x\n", "b.csv": "x\n", "c.csv": "y\n", "d.csv": "z\n"} {
+				Expect(os.WriteFile(filepath.Join(source, name), []byte(content), 0600)).To(Succeed())
+			}
+			store := watsonxdatav2.NewLocalIngestionFingerprintStore(filepath.Join(dir, "state", "fingerprints.json"))
+			for name, jobID := range map[string]string{"c.csv": "job-c", "d.csv": "job-d"} {
+				fingerprint, err := watsonxdatav2.FingerprintLocalFile(filepath.Join(source, name))
+				Expect(err).To(BeNil())
+				Expect(store.Record("iceberg_data.sales.orders", fingerprint, jobID)).To(Succeed())
+			}
+
+			ingestLocalFilesOptionsModel := watsonxDataService.NewIngestLocalFilesOptions("crn:1", source, "iceberg_data.sales.orders", "ibmlhadmin")
+			ingestLocalFilesOptionsModel.SetSkipIngested(true)
+			ingestLocalFilesOptionsModel.SetFingerprintStore(store)
+			report, operationErr := watsonxDataService.IngestLocalFiles(ingestLocalFilesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(report.Succeeded).To(Equal(int64(2)))
+			Expect(report.AlreadyIngested).To(Equal(int64(2)))
+			Expect(report.Failed).To(Equal(int64(0)))
+			Expect(report.Files[0].Fingerprint).To(HavePrefix("sha256:"))
+			Expect(report.Files[1].DuplicateOf).To(Equal(filepath.Join(source, "a.csv")))
+			Expect(report.Files[2].PreviousJobID).To(Equal("job-c"))
+			Expect(report.Files[3].Job).ToNot(BeNil())
+			Expect(created).To(ConsistOf(report.Files[0].JobID, report.Files[3].JobID))
+
+			jobID, found, err := store.Lookup("iceberg_data.sales.orders", report.Files[3].Fingerprint)
+			Expect(err).To(BeNil())
+			Expect(found).To(BeTrue())
+			Expect(jobID).To(Equal(report.Files[3].JobID))
+
+			report, operationErr = watsonxDataService.IngestLocalFiles(ingestLocalFilesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(report.Succeeded).To(Equal(int64(0)))
+			Expect(report.AlreadyIngested).To(Equal(int64(4)))
+			Expect(created).To(HaveLen(2))
+
+			ingestLocalFilesOptionsModel.SetTargetTable("iceberg_data.sales.orders_copy")
+			report, operationErr = watsonxDataService.IngestLocalFiles(ingestLocalFilesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(report.Succeeded).To(Equal(int64(3)))
+		})
+		It(`Upload a copy when the first file with the same content fails`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			source := filepath.Join(dir, "data")
+			Expect(os.Mkdir(source, 0700)).To(Succeed())
+			for _, name := range []string{"a.csv", "b.csv", "c.csv"} {
+				Expect(os.WriteFile(filepath.Join(source, name), []byte("x\n"), 0600)).To(Succeed())
+			}
+			failUploads = 1
+
+			ingestLocalFilesOptionsModel := watsonxDataService.NewIngestLocalFilesOptions("crn:1", source, "iceberg_data.sales.orders", "ibmlhadmin")
+			ingestLocalFilesOptionsModel.SetSkipIngested(true)
+			ingestLocalFilesOptionsModel.SetFingerprintStore(watsonxdatav2.NewLocalIngestionFingerprintStore(filepath.Join(dir, "fingerprints.json")))
+			output := new(bytes.Buffer)
+			ingestLocalFilesOptionsModel.SetOutput(output)
+			report, operationErr := watsonxDataService.IngestLocalFiles(ingestLocalFilesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(report.Failed).To(Equal(int64(1)))
+			Expect(report.Succeeded).To(Equal(int64(1)))
+			Expect(report.AlreadyIngested).To(Equal(int64(1)))
+			Expect(report.Files[0].Err).ToNot(BeNil())
+			Expect(report.Files[1].DuplicateOf).To(BeEmpty())
+			Expect(report.Files[1].Job).ToNot(BeNil())
+			Expect(report.Files[2].DuplicateOf).To(Equal(filepath.Join(source, "b.csv")))
+			Expect(created).To(Equal([]string{report.Files[1].JobID}))
+			Expect(output.String()).To(ContainSubstring("skipped " + filepath.Join(source, "c.csv") + ": same content as " + filepath.Join(source, "b.csv")))
+		})
+		It(`Count a file as ingested when recording its fingerprint fails`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			source := filepath.Join(dir, "data")
+			Expect(os.Mkdir(source, 0700)).To(Succeed())
+			for _, name := range []string{"a.csv", "b.csv"} {
+				Expect(os.WriteFile(filepath.Join(source, name), []byte("x\n"), 0600)).To(Succeed())
+			}
+
+			ingestLocalFilesOptionsModel := watsonxDataService.NewIngestLocalFilesOptions("crn:1", source, "iceberg_data.sales.orders", "ibmlhadmin")
+			ingestLocalFilesOptionsModel.SetSkipIngested(true)
+			ingestLocalFilesOptionsModel.SetFingerprintStore(failingRecordStore{})
+			output := new(bytes.Buffer)
+			ingestLocalFilesOptionsModel.SetOutput(output)
+			report, operationErr := watsonxDataService.IngestLocalFiles(ingestLocalFilesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(report.Succeeded).To(Equal(int64(1)))
+			Expect(report.AlreadyIngested).To(Equal(int64(1)))
+			Expect(report.Failed).To(Equal(int64(0)))
+			Expect(report.Files[0].Err).To(BeNil())
+			Expect(report.Files[0].RecordErr).To(MatchError("store unavailable"))
+			Expect(report.Files[1].DuplicateOf).To(Equal(filepath.Join(source, "a.csv")))
+			Expect(created).To(Equal([]string{report.Files[0].JobID}))
+			Expect(output.String()).To(ContainSubstring("cannot record fingerprint: store unavailable"))
+		})
+	})
+	Describe(`CreateIngestionJobsOnce(createIngestionJobsOnceOptions *CreateIngestionJobsOnceOptions)`, func() {
+		It(`Create a job once per source paths and target table`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			result, operationErr := watsonxDataService.CreateIngestionJobsOnce(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			Expect(watsonxdatav2.FingerprintObjectPaths("s3://b/1.csv, s3://b/2.csv")).To(Equal(watsonxdatav2.FingerprintObjectPaths("s3://b/2.csv,s3://b/1.csv")))
+
+			storePath := filepath.Join(dir, "fingerprints.json")
+			createIngestionJobsOnceOptionsModel := watsonxDataService.NewCreateIngestionJobsOnceOptions(
+				watsonxDataService.NewCreateIngestionJobsOptions("crn:1", "load-1", "s3://b/1.csv,s3://b/2.csv", "iceberg_data.sales.orders", "ibmlhadmin"))
+			createIngestionJobsOnceOptionsModel.SetFingerprintStore(watsonxdatav2.NewLocalIngestionFingerprintStore(storePath))
+			result, operationErr = watsonxDataService.CreateIngestionJobsOnce(createIngestionJobsOnceOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(*result.Job.JobID).To(Equal("load-1"))
+			Expect(result.PreviousJobID).To(Equal(""))
+
+			createIngestionJobsOnceOptionsModel.SetCreateIngestionJobsOptions(
+				watsonxDataService.NewCreateIngestionJobsOptions("crn:1", "load-2", "s3://b/2.csv,s3://b/1.csv", "iceberg_data.sales.orders", "ibmlhadmin"))
+			result, operationErr = watsonxDataService.CreateIngestionJobsOnce(createIngestionJobsOnceOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.Job).To(BeNil())
+			Expect(result.PreviousJobID).To(Equal("load-1"))
+			Expect(created).To(Equal([]string{"load-1"}))
+
+			content, err := os.ReadFile(storePath)
+			Expect(err).To(BeNil())
+			var fingerprints map[string]map[string]string
+			Expect(json.Unmarshal(content, &fingerprints)).To(Succeed())
+			Expect(fingerprints["iceberg_data.sales.orders"]).To(Equal(map[string]string{result.Fingerprint: "load-1"}))
+
+			Expect(os.WriteFile(storePath, []byte("not json"), 0600)).To(Succeed())
+			_, operationErr = watsonxDataService.CreateIngestionJobsOnce(createIngestionJobsOnceOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+		})
+	})
+})
+
+// failingRecordStore is an IngestionFingerprintStore that finds nothing and cannot record.
+type failingRecordStore struct{}
+
+func (failingRecordStore) Lookup(targetTable string, fingerprint string) (string, bool, error) {
+	return "", false, nil
+}
+
+func (failingRecordStore) Record(targetTable string, fingerprint string, jobID string) error {
+	return errors.New("store unavailable")
+}
@@ -0,0 +1,416 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// ingestionJobSucceededStatuses are the statuses of ingestion jobs that completed successfully.
+var ingestionJobSucceededStatuses = []string{"completed", "succeeded", "success", "finished"}
+
+// ingestionJobFailedStatuses are the statuses of ingestion jobs that did not complete.
+var ingestionJobFailedStatuses = []string{IngestionJobStatusFailed, "error", "cancelled", "canceled"}
+
+// ingestionJobRecordColumns are the CSV columns written by IngestionJobRecords.WriteCSV.
+var ingestionJobRecordColumns = []string{
+	"job_id",
+	"status",
+	"username",
+	"target_table",
+	"engine_id",
+	"engine_name",
+	"source_file_type",
+	"source_data_files",
+	"start_time",
+	"end_time",
+	"duration_seconds",
+	"details",
+}
+
+// IngestionJobRecord : A flattened ingestion job with parsed times.
+type IngestionJobRecord struct {
+	// Job ID.
+	JobID string `json:"job_id"`
+
+	// Job status.
+	Status string `json:"status,omitempty"`
+
+	// Ingestion job user.
+	Username string `json:"username,omitempty"`
+
+	// Target table name in format catalog.schema.table.
+	TargetTable string `json:"target_table,omitempty"`
+
+	// ID of the spark engine.
+	EngineID string `json:"engine_id,omitempty"`
+
+	// Name of the spark engine.
+	EngineName string `json:"engine_name,omitempty"`
+
+	// Source file type.
+	SourceFileType string `json:"source_file_type,omitempty"`
+
+	// Source data location.
+	SourceDataFiles string `json:"source_data_files,omitempty"`
+
+	// Start time.
+	StartTime *time.Time `json:"start_time,omitempty"`
+
+	// End time.
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// Run time in seconds, if the job has started and ended.
+	DurationSeconds *float64 `json:"duration_seconds,omitempty"`
+
+	// Error messages of a failed job.
+	Details string `json:"details,omitempty"`
+}
+
+// NewIngestionJobRecord : Instantiate IngestionJobRecord from an ingestion job
+// Timestamps that cannot be parsed are left unset.
+func NewIngestionJobRecord(job *IngestionJob) *IngestionJobRecord {
+	record := &IngestionJobRecord{
+		JobID:           core.StringNilMapper(job.JobID),
+		Status:          core.StringNilMapper(job.Status),
+		Username:        core.StringNilMapper(job.Username),
+		TargetTable:     core.StringNilMapper(job.TargetTable),
+		EngineID:        core.StringNilMapper(job.EngineID),
+		EngineName:      core.StringNilMapper(job.EngineName),
+		SourceFileType:  core.StringNilMapper(job.SourceFileType),
+		SourceDataFiles: core.StringNilMapper(job.SourceDataFiles),
+		StartTime:       ingestionJobTime(job.StartTimestamp),
+		EndTime:         ingestionJobTime(job.EndTimestamp),
+		Details:         core.StringNilMapper(job.Details),
+	}
+	if record.StartTime != nil && record.EndTime != nil {
+		seconds := record.EndTime.Sub(*record.StartTime).Seconds()
+		record.DurationSeconds = &seconds
+	}
+	return record
+}
+
+// Duration : Return the run time of the job. ok is false if it has not both started and ended.
+func (record *IngestionJobRecord) Duration() (duration time.Duration, ok bool) {
+	if record.DurationSeconds == nil {
+		return
+	}
+	return time.Duration(*record.DurationSeconds * float64(time.Second)), true
+}
+
+// Succeeded : Return whether the job completed successfully.
+func (record *IngestionJobRecord) Succeeded() bool {
+	return containsFold(ingestionJobSucceededStatuses, record.Status)
+}
+
+// Failed : Return whether the job ended without completing.
+func (record *IngestionJobRecord) Failed() bool {
+	return containsFold(ingestionJobFailedStatuses, record.Status)
+}
+
+// IngestionJobSummary : Counts, success rate and durations of a set of ingestion jobs.
+type IngestionJobSummary struct {
+	// Number of jobs.
+	Total int64 `json:"total"`
+
+	// Number of jobs that completed successfully.
+	Succeeded int64 `json:"succeeded"`
+
+	// Number of jobs that ended without completing.
+	Failed int64 `json:"failed"`
+
+	// Number of jobs that have not ended.
+	InProgress int64 `json:"in_progress"`
+
+	// Succeeded jobs as a share of ended jobs, from 0 to 1. Not set if no job has ended.
+	SuccessRate *float64 `json:"success_rate,omitempty"`
+
+	// Shortest run time of the succeeded jobs, in seconds.
+	MinDurationSeconds *float64 `json:"min_duration_seconds,omitempty"`
+
+	// Mean run time of the succeeded jobs, in seconds.
+	MeanDurationSeconds *float64 `json:"mean_duration_seconds,omitempty"`
+
+	// 95th percentile (nearest rank) of the run time of the succeeded jobs, in seconds.
+	P95DurationSeconds *float64 `json:"p95_duration_seconds,omitempty"`
+
+	// Longest run time of the succeeded jobs, in seconds.
+	MaxDurationSeconds *float64 `json:"max_duration_seconds,omitempty"`
+}
+
+// IngestionJobRecords : A list of ingestion job records.
+type IngestionJobRecords []IngestionJobRecord
+
+// Summary : Return the counts, success rate and durations of the records.
+func (records IngestionJobRecords) Summary() *IngestionJobSummary {
+	summary := new(IngestionJobSummary)
+	var durations []float64
+	for i := range records {
+		record := &records[i]
+		summary.Total++
+		switch {
+		case record.Succeeded():
+			summary.Succeeded++
+			if record.DurationSeconds != nil {
+				durations = append(durations, *record.DurationSeconds)
+			}
+		case record.Failed():
+			summary.Failed++
+		default:
+			summary.InProgress++
+		}
+	}
+	if ended := summary.Succeeded + summary.Failed; ended > 0 {
+		rate := float64(summary.Succeeded) / float64(ended)
+		summary.SuccessRate = &rate
+	}
+	if len(durations) > 0 {
+		sort.Float64s(durations)
+		sum := 0.0
+		for _, duration := range durations {
+			sum += duration
+		}
+		mean := sum / float64(len(durations))
+		p95 := durations[int(math.Ceil(0.95*float64(len(durations))))-1]
+		summary.MinDurationSeconds = &durations[0]
+		summary.MeanDurationSeconds = &mean
+		summary.P95DurationSeconds = &p95
+		summary.MaxDurationSeconds = &durations[len(durations)-1]
+	}
+	return summary
+}
+
+// SummaryByTargetTable : Return the summary of the records of each target table.
+func (records IngestionJobRecords) SummaryByTargetTable() map[string]*IngestionJobSummary {
+	groups := map[string]IngestionJobRecords{}
+	for _, record := range records {
+		groups[record.TargetTable] = append(groups[record.TargetTable], record)
+	}
+	summaries := make(map[string]*IngestionJobSummary, len(groups))
+	for table, group := range groups {
+		summaries[table] = group.Summary()
+	}
+	return summaries
+}
+
+// WriteJSON writes the records to writer as a JSON array.
+func (records IngestionJobRecords) WriteJSON(writer io.Writer) error {
+	return writeRecordsJSON(writer, records)
+}
+
+// WriteCSV writes the records to writer as CSV with a header row. Times are in RFC 3339 format.
+func (records IngestionJobRecords) WriteCSV(writer io.Writer) error {
+	return writeRecordsCSV(writer, ingestionJobRecordColumns, len(records), func(i int) []string {
+		record := &records[i]
+		return []string{
+			record.JobID,
+			record.Status,
+			record.Username,
+			record.TargetTable,
+			record.EngineID,
+			record.EngineName,
+			record.SourceFileType,
+			record.SourceDataFiles,
+			formatRecordTime(record.StartTime),
+			formatRecordTime(record.EndTime),
+			formatRecordSeconds(record.DurationSeconds),
+			record.Details,
+		}
+	})
+}
+
+// QueryIngestionJobs : Find ingestion jobs matching a filter
+// All jobs are listed with IngestionJobsPager and the filters are applied to them. Every filter that is set must
+// match. Results are ordered by start time, newest first, with jobs that have not started at the end.
+func (watsonxData *WatsonxDataV2) QueryIngestionJobs(queryIngestionJobsOptions *QueryIngestionJobsOptions) (result IngestionJobRecords, err error) {
+	result, err = watsonxData.QueryIngestionJobsWithContext(context.Background(), queryIngestionJobsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// QueryIngestionJobsWithContext is an alternate form of the QueryIngestionJobs method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) QueryIngestionJobsWithContext(ctx context.Context, queryIngestionJobsOptions *QueryIngestionJobsOptions) (result IngestionJobRecords, err error) {
+	err = core.ValidateNotNil(queryIngestionJobsOptions, "queryIngestionJobsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(queryIngestionJobsOptions, "queryIngestionJobsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := queryIngestionJobsOptions
+	if opts.TargetTable != nil {
+		if _, err = path.Match(*opts.TargetTable, ""); err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("invalid target table pattern '%s'", *opts.TargetTable), "invalid-target-table-pattern", common.GetComponentInfo())
+			return
+		}
+	}
+
+	pager, err := watsonxData.NewIngestionJobsPager(&ListIngestionJobsOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		JobsPerPage:    opts.JobsPerPage,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		return
+	}
+	jobs, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+
+	result = IngestionJobRecords{}
+	for i := range jobs {
+		record := NewIngestionJobRecord(&jobs[i])
+		if opts.matches(record) {
+			result = append(result, *record)
+		}
+	}
+	sortRecordsNewestFirst(result, func(i int) *time.Time {
+		return result[i].StartTime
+	})
+	return
+}
+
+// matches reports whether the record passes every filter that is set.
+func (opts *QueryIngestionJobsOptions) matches(record *IngestionJobRecord) bool {
+	if len(opts.Status) > 0 && !containsFold(opts.Status, record.Status) {
+		return false
+	}
+	if opts.Username != nil && *opts.Username != record.Username {
+		return false
+	}
+	if opts.TargetTable != nil {
+		if ok, _ := path.Match(*opts.TargetTable, record.TargetTable); !ok {
+			return false
+		}
+	}
+	if opts.Engine != nil && *opts.Engine != record.EngineID && *opts.Engine != record.EngineName {
+		return false
+	}
+	if opts.StartedAfter != nil || opts.StartedBefore != nil {
+		if record.StartTime == nil ||
+			(opts.StartedAfter != nil && record.StartTime.Before(*opts.StartedAfter)) ||
+			(opts.StartedBefore != nil && !record.StartTime.Before(*opts.StartedBefore)) {
+			return false
+		}
+	}
+	return true
+}
+
+// QueryIngestionJobsOptions : The QueryIngestionJobs options.
+type QueryIngestionJobsOptions struct {
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId" validate:"required"`
+
+	// Job statuses, matched without regard to case.
+	Status []string `json:"status,omitempty"`
+
+	// Ingestion job user.
+	Username *string `json:"username,omitempty"`
+
+	// Glob pattern, as in path.Match, for the target table name in format catalog.schema.table.
+	TargetTable *string `json:"target_table,omitempty"`
+
+	// ID or name of the spark engine.
+	Engine *string `json:"engine,omitempty"`
+
+	// Only jobs started at or after this time.
+	StartedAfter *time.Time `json:"started_after,omitempty"`
+
+	// Only jobs started before this time.
+	StartedBefore *time.Time `json:"started_before,omitempty"`
+
+	// Number of ingestion jobs listed per request.
+	JobsPerPage *int64 `json:"jobs_per_page,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewQueryIngestionJobsOptions : Instantiate QueryIngestionJobsOptions
+func (*WatsonxDataV2) NewQueryIngestionJobsOptions(authInstanceID string) *QueryIngestionJobsOptions {
+	return &QueryIngestionJobsOptions{
+		AuthInstanceID: core.StringPtr(authInstanceID),
+	}
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *QueryIngestionJobsOptions) SetAuthInstanceID(authInstanceID string) *QueryIngestionJobsOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetStatus : Allow user to set Status
+func (_options *QueryIngestionJobsOptions) SetStatus(status []string) *QueryIngestionJobsOptions {
+	_options.Status = status
+	return _options
+}
+
+// SetUsername : Allow user to set Username
+func (_options *QueryIngestionJobsOptions) SetUsername(username string) *QueryIngestionJobsOptions {
+	_options.Username = core.StringPtr(username)
+	return _options
+}
+
+// SetTargetTable : Allow user to set TargetTable
+func (_options *QueryIngestionJobsOptions) SetTargetTable(targetTable string) *QueryIngestionJobsOptions {
+	_options.TargetTable = core.StringPtr(targetTable)
+	return _options
+}
+
+// SetEngine : Allow user to set Engine
+func (_options *QueryIngestionJobsOptions) SetEngine(engine string) *QueryIngestionJobsOptions {
+	_options.Engine = core.StringPtr(engine)
+	return _options
+}
+
+// SetStartedAfter : Allow user to set StartedAfter
+func (_options *QueryIngestionJobsOptions) SetStartedAfter(startedAfter time.Time) *QueryIngestionJobsOptions {
+	_options.StartedAfter = &startedAfter
+	return _options
+}
+
+// SetStartedBefore : Allow user to set StartedBefore
+func (_options *QueryIngestionJobsOptions) SetStartedBefore(startedBefore time.Time) *QueryIngestionJobsOptions {
+	_options.StartedBefore = &startedBefore
+	return _options
+}
+
+// SetJobsPerPage : Allow user to set JobsPerPage
+func (_options *QueryIngestionJobsOptions) SetJobsPerPage(jobsPerPage int64) *QueryIngestionJobsOptions {
+	_options.JobsPerPage = core.Int64Ptr(jobsPerPage)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *QueryIngestionJobsOptions) SetHeaders(param map[string]string) *QueryIngestionJobsOptions {
+	options.Headers = param
+	return options
+}
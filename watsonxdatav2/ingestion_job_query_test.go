@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 ingestion job query`, func() {
+	var testServer *httptest.Server
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/ingestion_jobs"))
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Query().Get("start") == "" {
+				fmt.Fprintf(res, `{"ingestion_jobs": [
+					{"job_id": "j1", "status": "completed", "username": "alice", "target_table": "iceberg_data.sales.orders", "engine_id": "spark01",
+					 "start_timestamp": "1748736000", "end_timestamp": "1748736100"},
+					{"job_id": "j2", "status": "failed", "username": "bob", "target_table": "iceberg_data.sales.orders", "engine_id": "spark01",
+					 "start_timestamp": "1748822400", "end_timestamp": "1748822430", "details": "Path does not exist, \"s3://x\""}
+				], "next": {"href": "%s/ingestion_jobs?start=2"}}`, testServer.URL)
+				return
+			}
+			fmt.Fprint(res, `{"ingestion_jobs": [
+				{"job_id": "j3", "status": "COMPLETED", "username": "alice", "target_table": "iceberg_data.crm.customers", "engine_id": "spark02",
+				 "engine_name": "nightly", "start_timestamp": "1748908800", "end_timestamp": "1748909100"},
+				{"job_id": "j4", "status": "running", "username": "alice", "target_table": "iceberg_data.sales.orders", "start_timestamp": "1748995200"},
+				{"job_id": "j5", "status": "starting", "username": "alice", "target_table": "iceberg_data.sales.orders"}
+			]}`)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`QueryIngestionJobs(queryIngestionJobsOptions *QueryIngestionJobsOptions)`, func() {
+		It(`Filter ingestion jobs`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			records, operationErr := watsonxDataService.QueryIngestionJobs(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(records).To(BeNil())
+
+			ids := func() (ids []string) {
+				for _, record := range records {
+					ids = append(ids, record.JobID)
+				}
+				return
+			}
+			records, operationErr = watsonxDataService.QueryIngestionJobs(watsonxDataService.NewQueryIngestionJobsOptions("crn:1"))
+			Expect(operationErr).To(BeNil())
+			Expect(ids()).To(Equal([]string{"j4", "j3", "j2", "j1", "j5"}))
+			Expect(*records[3].StartTime).To(Equal(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)))
+			duration, ok := records[3].Duration()
+			Expect(ok).To(BeTrue())
+			Expect(duration).To(Equal(100 * time.Second))
+
+			queryIngestionJobsOptionsModel := watsonxDataService.NewQueryIngestionJobsOptions("crn:1")
+			queryIngestionJobsOptionsModel.SetStatus([]string{"Completed", "failed"})
+			queryIngestionJobsOptionsModel.SetTargetTable("iceberg_data.sales.*")
+			records, operationErr = watsonxDataService.QueryIngestionJobs(queryIngestionJobsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(ids()).To(Equal([]string{"j2", "j1"}))
+
+			queryIngestionJobsOptionsModel = watsonxDataService.NewQueryIngestionJobsOptions("crn:1")
+			queryIngestionJobsOptionsModel.SetUsername("alice")
+			queryIngestionJobsOptionsModel.SetEngine("nightly")
+			records, operationErr = watsonxDataService.QueryIngestionJobs(queryIngestionJobsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(ids()).To(Equal([]string{"j3"}))
+
+			queryIngestionJobsOptionsModel = watsonxDataService.NewQueryIngestionJobsOptions("crn:1")
+			queryIngestionJobsOptionsModel.SetStartedAfter(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC))
+			queryIngestionJobsOptionsModel.SetStartedBefore(time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC))
+			records, operationErr = watsonxDataService.QueryIngestionJobs(queryIngestionJobsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(ids()).To(Equal([]string{"j3", "j2"}))
+
+			queryIngestionJobsOptionsModel.SetTargetTable("[")
+			_, operationErr = watsonxDataService.QueryIngestionJobs(queryIngestionJobsOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+		})
+		It(`Summarize and export records`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			records, operationErr := watsonxDataService.QueryIngestionJobs(watsonxDataService.NewQueryIngestionJobsOptions("crn:1"))
+			Expect(operationErr).To(BeNil())
+
+			summary := records.Summary()
+			Expect(summary.Total).To(Equal(int64(5)))
+			Expect(summary.Succeeded).To(Equal(int64(2)))
+			Expect(summary.Failed).To(Equal(int64(1)))
+			Expect(summary.InProgress).To(Equal(int64(2)))
+			Expect(*summary.SuccessRate).To(BeNumerically("~", 2.0/3.0))
+			Expect(*summary.MinDurationSeconds).To(Equal(float64(100)))
+			Expect(*summary.MeanDurationSeconds).To(Equal(float64(200)))
+			Expect(*summary.P95DurationSeconds).To(Equal(float64(300)))
+			Expect(*summary.MaxDurationSeconds).To(Equal(float64(300)))
+
+			byTable := records.SummaryByTargetTable()
+			Expect(byTable).To(HaveLen(2))
+			Expect(*byTable["iceberg_data.sales.orders"].SuccessRate).To(Equal(0.5))
+			Expect(byTable["iceberg_data.crm.customers"].Total).To(Equal(int64(1)))
+
+			Expect(watsonxdatav2.IngestionJobRecords(nil).Summary().SuccessRate).To(BeNil())
+
+			output := new(bytes.Buffer)
+			Expect(records[2:4].WriteCSV(output)).To(Succeed())
+			Expect(output.String()).To(Equal(
+				"job_id,status,username,target_table,engine_id,engine_name,source_file_type,source_data_files,start_time,end_time,duration_seconds,details\n" +
+					"j2,failed,bob,iceberg_data.sales.orders,spark01,,,,2025-06-02T00:00:00Z,2025-06-02T00:00:30Z,30,\"Path does not exist, \"\"s3://x\"\"\"\n" +
+					"j1,completed,alice,iceberg_data.sales.orders,spark01,,,,2025-06-01T00:00:00Z,2025-06-01T00:01:40Z,100,\n"))
+
+			output.Reset()
+			Expect(records[4:].WriteJSON(output)).To(Succeed())
+			var exported []map[string]interface{}
+			Expect(json.Unmarshal(output.Bytes(), &exported)).To(Succeed())
+			Expect(exported).To(Equal([]map[string]interface{}{
+				{
+					"job_id":       "j5",
+					"status":       "starting",
+					"username":     "alice",
+					"target_table": "iceberg_data.sales.orders",
+				},
+			}))
+		})
+	})
+})
@@ -0,0 +1,454 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// IngestionJobStatusFailed is the status of a failed ingestion job.
+const IngestionJobStatusFailed = "failed"
+
+// retryJobIDSuffix matches the suffix added to the job ID of a retried ingestion job.
+var retryJobIDSuffix = regexp.MustCompile(`^(.+)-retry-(\d+)$`)
+
+// RetryIngestionJobID : Return the job ID of the next retry of an ingestion job
+// The first retry of "orders" is "orders-retry-1", the retry of "orders-retry-1" is "orders-retry-2".
+func RetryIngestionJobID(jobID string) string {
+	if match := retryJobIDSuffix.FindStringSubmatch(jobID); match != nil {
+		attempt, err := strconv.ParseInt(match[2], 10, 64)
+		if err == nil {
+			return fmt.Sprintf("%s-retry-%d", match[1], attempt+1)
+		}
+	}
+	return jobID + "-retry-1"
+}
+
+// ingestionJobTime returns a Unix timestamp of an ingestion job as a time, or nil if it is not set or invalid.
+func ingestionJobTime(timestamp *string) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+	seconds, err := strconv.ParseInt(strings.TrimSpace(*timestamp), 10, 64)
+	if err != nil {
+		return nil
+	}
+	t := time.Unix(seconds, 0).UTC()
+	return &t
+}
+
+// IngestionJobOverrides : Fields that replace those of a retried ingestion job. Fields that are not set are copied
+// from the job.
+type IngestionJobOverrides struct {
+	// Create new target table (if True); Insert into pre-existing target table (if False).
+	CreateIfNotExist *bool `json:"create_if_not_exist,omitempty"`
+
+	// Ingestion CSV properties.
+	CsvProperty *IngestionJobPrototypeCsvProperty `json:"csv_property,omitempty"`
+
+	// ID of the spark engine to be used for ingestion.
+	EngineID *string `json:"engine_id,omitempty"`
+
+	// Ingestion engine configuration.
+	ExecuteConfig *IngestionJobPrototypeExecuteConfig `json:"execute_config,omitempty"`
+
+	// Partition by expression of the target table.
+	PartitionBy *string `json:"partition_by,omitempty"`
+
+	// Schema definition of the source table.
+	Schema *string `json:"schema,omitempty"`
+
+	// Comma separated source file or directory path.
+	SourceDataFiles *string `json:"source_data_files,omitempty"`
+
+	// Source file types (parquet or csv or json).
+	SourceFileType *string `json:"source_file_type,omitempty"`
+
+	// Target table name in format catalog.schema.table.
+	TargetTable *string `json:"target_table,omitempty"`
+
+	// User submitting ingestion job.
+	Username *string `json:"username,omitempty"`
+
+	// Validate CSV header if the target table exist.
+	ValidateCsvHeader *bool `json:"validate_csv_header,omitempty"`
+}
+
+// applyTo replaces the fields of createIngestionJobsOptions that are set in overrides.
+func (overrides *IngestionJobOverrides) applyTo(createIngestionJobsOptions *CreateIngestionJobsOptions) {
+	if overrides == nil {
+		return
+	}
+	if overrides.CreateIfNotExist != nil {
+		createIngestionJobsOptions.CreateIfNotExist = overrides.CreateIfNotExist
+	}
+	if overrides.CsvProperty != nil {
+		createIngestionJobsOptions.CsvProperty = overrides.CsvProperty
+	}
+	if overrides.EngineID != nil {
+		createIngestionJobsOptions.EngineID = overrides.EngineID
+	}
+	if overrides.ExecuteConfig != nil {
+		createIngestionJobsOptions.ExecuteConfig = overrides.ExecuteConfig
+	}
+	if overrides.PartitionBy != nil {
+		createIngestionJobsOptions.PartitionBy = overrides.PartitionBy
+	}
+	if overrides.Schema != nil {
+		createIngestionJobsOptions.Schema = overrides.Schema
+	}
+	if overrides.SourceDataFiles != nil {
+		createIngestionJobsOptions.SourceDataFiles = overrides.SourceDataFiles
+	}
+	if overrides.SourceFileType != nil {
+		createIngestionJobsOptions.SourceFileType = overrides.SourceFileType
+	}
+	if overrides.TargetTable != nil {
+		createIngestionJobsOptions.TargetTable = overrides.TargetTable
+	}
+	if overrides.Username != nil {
+		createIngestionJobsOptions.Username = overrides.Username
+	}
+	if overrides.ValidateCsvHeader != nil {
+		createIngestionJobsOptions.ValidateCsvHeader = overrides.ValidateCsvHeader
+	}
+}
+
+// NewCreateIngestionJobsOptionsFromJob : Instantiate CreateIngestionJobsOptions that resubmit an ingestion job
+// The source files, target table, user, engine, CSV properties, execute config, partitioning, schema and flags are
+// copied from job.
+func (*WatsonxDataV2) NewCreateIngestionJobsOptionsFromJob(authInstanceID string, jobID string, job *IngestionJob) *CreateIngestionJobsOptions {
+	createIngestionJobsOptions := &CreateIngestionJobsOptions{
+		AuthInstanceID:    core.StringPtr(authInstanceID),
+		JobID:             core.StringPtr(jobID),
+		SourceDataFiles:   job.SourceDataFiles,
+		TargetTable:       job.TargetTable,
+		Username:          job.Username,
+		CreateIfNotExist:  job.CreateIfNotExist,
+		EngineID:          job.EngineID,
+		PartitionBy:       job.PartitionBy,
+		Schema:            job.Schema,
+		SourceFileType:    job.SourceFileType,
+		ValidateCsvHeader: job.ValidateCsvHeader,
+	}
+	if job.CsvProperty != nil {
+		createIngestionJobsOptions.CsvProperty = &IngestionJobPrototypeCsvProperty{
+			Encoding:        job.CsvProperty.Encoding,
+			EscapeCharacter: job.CsvProperty.EscapeCharacter,
+			FieldDelimiter:  job.CsvProperty.FieldDelimiter,
+			Header:          job.CsvProperty.Header,
+			LineDelimiter:   job.CsvProperty.LineDelimiter,
+		}
+	}
+	if job.ExecuteConfig != nil {
+		createIngestionJobsOptions.ExecuteConfig = &IngestionJobPrototypeExecuteConfig{
+			DriverCores:    job.ExecuteConfig.DriverCores,
+			DriverMemory:   job.ExecuteConfig.DriverMemory,
+			ExecutorCores:  job.ExecuteConfig.ExecutorCores,
+			ExecutorMemory: job.ExecuteConfig.ExecutorMemory,
+			NumExecutors:   job.ExecuteConfig.NumExecutors,
+		}
+	}
+	return createIngestionJobsOptions
+}
+
+// RetryIngestionJob : Resubmit an ingestion job
+// Fetch the job, copy its definition, apply the overrides and submit it under a new job ID, by default the one
+// returned by RetryIngestionJobID.
+func (watsonxData *WatsonxDataV2) RetryIngestionJob(retryIngestionJobOptions *RetryIngestionJobOptions) (result *IngestionJob, err error) {
+	result, err = watsonxData.RetryIngestionJobWithContext(context.Background(), retryIngestionJobOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RetryIngestionJobWithContext is an alternate form of the RetryIngestionJob method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) RetryIngestionJobWithContext(ctx context.Context, retryIngestionJobOptions *RetryIngestionJobOptions) (result *IngestionJob, err error) {
+	err = core.ValidateNotNil(retryIngestionJobOptions, "retryIngestionJobOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(retryIngestionJobOptions, "retryIngestionJobOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	job, _, err := watsonxData.GetIngestionJobWithContext(ctx, &GetIngestionJobOptions{
+		JobID:          retryIngestionJobOptions.JobID,
+		AuthInstanceID: retryIngestionJobOptions.AuthInstanceID,
+		Headers:        retryIngestionJobOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-ingestion-job-error")
+		return
+	}
+	newJobID := RetryIngestionJobID(*retryIngestionJobOptions.JobID)
+	if retryIngestionJobOptions.NewJobID != nil {
+		newJobID = *retryIngestionJobOptions.NewJobID
+	}
+	result, err = watsonxData.resubmitIngestionJob(ctx, *retryIngestionJobOptions.AuthInstanceID, newJobID, job, retryIngestionJobOptions.Overrides, retryIngestionJobOptions.Headers)
+	return
+}
+
+// resubmitIngestionJob submits a copy of job with the overrides applied under newJobID.
+func (watsonxData *WatsonxDataV2) resubmitIngestionJob(ctx context.Context, authInstanceID string, newJobID string, job *IngestionJob, overrides *IngestionJobOverrides, headers map[string]string) (result *IngestionJob, err error) {
+	createIngestionJobsOptions := watsonxData.NewCreateIngestionJobsOptionsFromJob(authInstanceID, newJobID, job)
+	overrides.applyTo(createIngestionJobsOptions)
+	createIngestionJobsOptions.Headers = headers
+	result, _, err = watsonxData.CreateIngestionJobsWithContext(ctx, createIngestionJobsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-ingestion-job-error")
+	}
+	return
+}
+
+// RetryIngestionJobOptions : The RetryIngestionJob options.
+type RetryIngestionJobOptions struct {
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId" validate:"required"`
+
+	// Job ID of the job to retry.
+	JobID *string `json:"job_id" validate:"required,ne="`
+
+	// Job ID of the new job. Defaults to RetryIngestionJobID(JobID).
+	NewJobID *string `json:"new_job_id,omitempty"`
+
+	// Fields that replace those of the job.
+	Overrides *IngestionJobOverrides `json:"overrides,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewRetryIngestionJobOptions : Instantiate RetryIngestionJobOptions
+func (*WatsonxDataV2) NewRetryIngestionJobOptions(authInstanceID string, jobID string) *RetryIngestionJobOptions {
+	return &RetryIngestionJobOptions{
+		AuthInstanceID: core.StringPtr(authInstanceID),
+		JobID:          core.StringPtr(jobID),
+	}
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *RetryIngestionJobOptions) SetAuthInstanceID(authInstanceID string) *RetryIngestionJobOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetJobID : Allow user to set JobID
+func (_options *RetryIngestionJobOptions) SetJobID(jobID string) *RetryIngestionJobOptions {
+	_options.JobID = core.StringPtr(jobID)
+	return _options
+}
+
+// SetNewJobID : Allow user to set NewJobID
+func (_options *RetryIngestionJobOptions) SetNewJobID(newJobID string) *RetryIngestionJobOptions {
+	_options.NewJobID = core.StringPtr(newJobID)
+	return _options
+}
+
+// SetOverrides : Allow user to set Overrides
+func (_options *RetryIngestionJobOptions) SetOverrides(overrides *IngestionJobOverrides) *RetryIngestionJobOptions {
+	_options.Overrides = overrides
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RetryIngestionJobOptions) SetHeaders(param map[string]string) *RetryIngestionJobOptions {
+	options.Headers = param
+	return options
+}
+
+// IngestionJobRetry : The resubmission of one failed ingestion job.
+type IngestionJobRetry struct {
+	// Job ID of the failed job.
+	JobID string
+
+	// Job ID of the new job.
+	NewJobID string
+
+	// The new job, if it was submitted.
+	Job *IngestionJob
+
+	// Submission error, if any.
+	Err error
+}
+
+// IngestionJobRetryReport : The result of RetryFailedIngestionJobs.
+type IngestionJobRetryReport struct {
+	// Failed jobs that were resubmitted, in listing order.
+	Retries []IngestionJobRetry
+
+	// Failed jobs whose next retry ID is already taken, so they have been retried before.
+	AlreadyRetried []string
+
+	// Number of jobs submitted.
+	Succeeded int64
+
+	// Number of jobs that could not be submitted.
+	Failed int64
+}
+
+// RetryFailedIngestionJobs : Resubmit every failed ingestion job in a time window
+// Jobs are listed with IngestionJobsPager. A failed job whose start time is in the window is resubmitted as
+// RetryIngestionJob does, unless a job with its next retry ID exists already. A submission error does not stop the
+// other retries; it is recorded in the report.
+func (watsonxData *WatsonxDataV2) RetryFailedIngestionJobs(retryFailedIngestionJobsOptions *RetryFailedIngestionJobsOptions) (result *IngestionJobRetryReport, err error) {
+	result, err = watsonxData.RetryFailedIngestionJobsWithContext(context.Background(), retryFailedIngestionJobsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RetryFailedIngestionJobsWithContext is an alternate form of the RetryFailedIngestionJobs method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) RetryFailedIngestionJobsWithContext(ctx context.Context, retryFailedIngestionJobsOptions *RetryFailedIngestionJobsOptions) (result *IngestionJobRetryReport, err error) {
+	err = core.ValidateNotNil(retryFailedIngestionJobsOptions, "retryFailedIngestionJobsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(retryFailedIngestionJobsOptions, "retryFailedIngestionJobsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := retryFailedIngestionJobsOptions
+
+	pager, err := watsonxData.NewIngestionJobsPager(&ListIngestionJobsOptions{
+		AuthInstanceID: opts.AuthInstanceID,
+		JobsPerPage:    opts.JobsPerPage,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		return
+	}
+	jobs, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-ingestion-jobs-error")
+		return
+	}
+	existing := map[string]bool{}
+	for _, job := range jobs {
+		if job.JobID != nil {
+			existing[*job.JobID] = true
+		}
+	}
+
+	result = new(IngestionJobRetryReport)
+	for i := range jobs {
+		job := &jobs[i]
+		if job.JobID == nil || job.Status == nil || !strings.EqualFold(*job.Status, IngestionJobStatusFailed) {
+			continue
+		}
+		started := ingestionJobTime(job.StartTimestamp)
+		if (opts.StartedAfter != nil || opts.StartedBefore != nil) && started == nil {
+			continue
+		}
+		if (opts.StartedAfter != nil && started.Before(*opts.StartedAfter)) || (opts.StartedBefore != nil && !started.Before(*opts.StartedBefore)) {
+			continue
+		}
+		newJobID := RetryIngestionJobID(*job.JobID)
+		if existing[newJobID] {
+			result.AlreadyRetried = append(result.AlreadyRetried, *job.JobID)
+			continue
+		}
+
+		retry := IngestionJobRetry{
+			JobID:    *job.JobID,
+			NewJobID: newJobID,
+		}
+		retry.Job, retry.Err = watsonxData.resubmitIngestionJob(ctx, *opts.AuthInstanceID, newJobID, job, opts.Overrides, opts.Headers)
+		if retry.Err != nil {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+		result.Retries = append(result.Retries, retry)
+	}
+	return
+}
+
+// RetryFailedIngestionJobsOptions : The RetryFailedIngestionJobs options.
+type RetryFailedIngestionJobsOptions struct {
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId" validate:"required"`
+
+	// Only retry jobs started at or after this time.
+	StartedAfter *time.Time `json:"started_after,omitempty"`
+
+	// Only retry jobs started before this time.
+	StartedBefore *time.Time `json:"started_before,omitempty"`
+
+	// Fields that replace those of every retried job.
+	Overrides *IngestionJobOverrides `json:"overrides,omitempty"`
+
+	// Number of ingestion jobs listed per request.
+	JobsPerPage *int64 `json:"jobs_per_page,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewRetryFailedIngestionJobsOptions : Instantiate RetryFailedIngestionJobsOptions
+func (*WatsonxDataV2) NewRetryFailedIngestionJobsOptions(authInstanceID string) *RetryFailedIngestionJobsOptions {
+	return &RetryFailedIngestionJobsOptions{
+		AuthInstanceID: core.StringPtr(authInstanceID),
+	}
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *RetryFailedIngestionJobsOptions) SetAuthInstanceID(authInstanceID string) *RetryFailedIngestionJobsOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetStartedAfter : Allow user to set StartedAfter
+func (_options *RetryFailedIngestionJobsOptions) SetStartedAfter(startedAfter time.Time) *RetryFailedIngestionJobsOptions {
+	_options.StartedAfter = &startedAfter
+	return _options
+}
+
+// SetStartedBefore : Allow user to set StartedBefore
+func (_options *RetryFailedIngestionJobsOptions) SetStartedBefore(startedBefore time.Time) *RetryFailedIngestionJobsOptions {
+	_options.StartedBefore = &startedBefore
+	return _options
+}
+
+// SetOverrides : Allow user to set Overrides
+func (_options *RetryFailedIngestionJobsOptions) SetOverrides(overrides *IngestionJobOverrides) *RetryFailedIngestionJobsOptions {
+	_options.Overrides = overrides
+	return _options
+}
+
+// SetJobsPerPage : Allow user to set JobsPerPage
+func (_options *RetryFailedIngestionJobsOptions) SetJobsPerPage(jobsPerPage int64) *RetryFailedIngestionJobsOptions {
+	_options.JobsPerPage = core.Int64Ptr(jobsPerPage)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RetryFailedIngestionJobsOptions) SetHeaders(param map[string]string) *RetryFailedIngestionJobsOptions {
+	options.Headers = param
+	return options
+}
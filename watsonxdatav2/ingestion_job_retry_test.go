@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 ingestion job retry`, func() {
+	var testServer *httptest.Server
+	var submitted []map[string]interface{}
+	job := func(jobID string, status string, start int64) string {
+		return fmt.Sprintf(`{"job_id": "%s", "status": "%s", "start_timestamp": "%d", "source_data_files": "s3://bucket/%s.csv",
+			"target_table": "iceberg_data.sales.orders", "username": "ibmlhadmin", "engine_id": "spark01", "partition_by": "day",
+			"csv_property": {"encoding": "utf-8", "field_delimiter": ";", "header": true},
+			"execute_config": {"driver_cores": 1, "driver_memory": "2G", "num_executors": 1}}`, jobID, status, start, jobID)
+	}
+	BeforeEach(func() {
+		submitted = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Header["Authinstanceid"]).To(Equal([]string{"crn:1"}))
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.EscapedPath() == "/ingestion_jobs" && req.URL.Query().Get("start") == "":
+				fmt.Fprintf(res, `{"ingestion_jobs": [%s, %s], "next": {"href": "%s/ingestion_jobs?start=page2"}}`,
+					job("jan", "failed", 1735722000), job("feb", "FAILED", 1738400400), testServer.URL)
+			case req.Method == "GET" && req.URL.EscapedPath() == "/ingestion_jobs":
+				Expect(req.URL.Query().Get("start")).To(Equal("page2"))
+				fmt.Fprintf(res, `{"ingestion_jobs": [%s, %s, %s, %s]}`,
+					job("feb-ok", "completed", 1738400400), job("feb-2", "failed", 1738486800),
+					job("feb-3", "failed", 1738486800), job("feb-3-retry-1", "running", 1738490400))
+			case req.Method == "GET" && req.URL.EscapedPath() == "/ingestion_jobs/orders-retry-1":
+				fmt.Fprint(res, job("orders-retry-1", "failed", 1738400400))
+			case req.Method == "POST" && req.URL.EscapedPath() == "/ingestion_jobs":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				submitted = append(submitted, body)
+				if body["job_id"] == "feb-2-retry-1" {
+					res.WriteHeader(409)
+					fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "job exists"}]}`)
+					return
+				}
+				res.WriteHeader(202)
+				fmt.Fprintf(res, `{"job_id": "%s", "status": "starting"}`, body["job_id"])
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`RetryIngestionJob(retryIngestionJobOptions *RetryIngestionJobOptions)`, func() {
+		It(`Resubmit a job with overrides`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			result, operationErr := watsonxDataService.RetryIngestionJob(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			Expect(watsonxdatav2.RetryIngestionJobID("orders")).To(Equal("orders-retry-1"))
+			Expect(watsonxdatav2.RetryIngestionJobID("orders-retry-9")).To(Equal("orders-retry-10"))
+
+			retryIngestionJobOptionsModel := watsonxDataService.NewRetryIngestionJobOptions("crn:1", "orders-retry-1")
+			retryIngestionJobOptionsModel.SetOverrides(&watsonxdatav2.IngestionJobOverrides{
+				ExecuteConfig: watsonxdatav2.IngestionExecuteConfigForSize(5 << 30),
+				EngineID:      core.StringPtr("spark02"),
+			})
+			result, operationErr = watsonxDataService.RetryIngestionJob(retryIngestionJobOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(*result.JobID).To(Equal("orders-retry-2"))
+			Expect(submitted).To(HaveLen(1))
+			Expect(submitted[0]).To(Equal(map[string]interface{}{
+				"job_id":            "orders-retry-2",
+				"source_data_files": "s3://bucket/orders-retry-1.csv",
+				"target_table":      "iceberg_data.sales.orders",
+				"username":          "ibmlhadmin",
+				"engine_id":         "spark02",
+				"partition_by":      "day",
+				"csv_property": map[string]interface{}{
+					"encoding":        "utf-8",
+					"field_delimiter": ";",
+					"header":          true,
+				},
+				"execute_config": map[string]interface{}{
+					"driver_cores":    float64(2),
+					"driver_memory":   "4G",
+					"executor_cores":  float64(2),
+					"executor_memory": "4G",
+					"num_executors":   float64(2),
+				},
+			}))
+
+			retryIngestionJobOptionsModel = watsonxDataService.NewRetryIngestionJobOptions("crn:1", "missing")
+			_, operationErr = watsonxDataService.RetryIngestionJob(retryIngestionJobOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+		})
+	})
+	Describe(`RetryFailedIngestionJobs(retryFailedIngestionJobsOptions *RetryFailedIngestionJobsOptions)`, func() {
+		It(`Resubmit failed jobs in a time window`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			report, operationErr := watsonxDataService.RetryFailedIngestionJobs(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(report).To(BeNil())
+
+			retryFailedIngestionJobsOptionsModel := watsonxDataService.NewRetryFailedIngestionJobsOptions("crn:1")
+			retryFailedIngestionJobsOptionsModel.SetStartedAfter(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+			retryFailedIngestionJobsOptionsModel.SetStartedBefore(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+			retryFailedIngestionJobsOptionsModel.SetOverrides(&watsonxdatav2.IngestionJobOverrides{
+				Username: core.StringPtr("etl"),
+			})
+			report, operationErr = watsonxDataService.RetryFailedIngestionJobs(retryFailedIngestionJobsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(report.AlreadyRetried).To(Equal([]string{"feb-3"}))
+			Expect(report.Retries).To(HaveLen(2))
+			Expect(report.Retries[0].JobID).To(Equal("feb"))
+			Expect(report.Retries[0].NewJobID).To(Equal("feb-retry-1"))
+			Expect(*report.Retries[0].Job.Status).To(Equal("starting"))
+			Expect(report.Retries[1].Err).ToNot(BeNil())
+			Expect(strings.Contains(report.Retries[1].Err.Error(), "job exists")).To(BeTrue())
+			Expect(report.Succeeded).To(Equal(int64(1)))
+			Expect(report.Failed).To(Equal(int64(1)))
+			Expect(submitted[0]["username"]).To(Equal("etl"))
+			Expect(submitted[0]["source_data_files"]).To(Equal("s3://bucket/feb.csv"))
+		})
+	})
+})
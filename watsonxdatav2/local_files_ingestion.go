@@ -0,0 +1,512 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// defaultLocalFilesIngestionConcurrency is the default number of files uploaded at the same time.
+const defaultLocalFilesIngestionConcurrency = 4
+
+// localIngestionFileTypes maps file extensions to the source file type and content type of the upload.
+var localIngestionFileTypes = map[string][2]string{
+	".csv":     {CreateIngestionJobsLocalFilesOptions_SourceFileType_Csv, "text/csv"},
+	".json":    {CreateIngestionJobsLocalFilesOptions_SourceFileType_JSON, "application/json"},
+	".jsonl":   {CreateIngestionJobsLocalFilesOptions_SourceFileType_JSON, "application/json"},
+	".ndjson":  {CreateIngestionJobsLocalFilesOptions_SourceFileType_JSON, "application/json"},
+	".parquet": {CreateIngestionJobsLocalFilesOptions_SourceFileType_Parquet, "application/octet-stream"},
+}
+
+// jobIDUnsafe matches the characters replaced when a file name becomes part of a job ID.
+var jobIDUnsafe = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// LocalFileIngestionResult : The outcome of ingesting one local file.
+type LocalFileIngestionResult struct {
+	// Path of the file.
+	Path string
+
+	// Source file type, from the file extension.
+	SourceFileType string
+
+	// Job ID of the ingestion job.
+	JobID string
+
+	// Created ingestion job, if the upload succeeded.
+	Job *IngestionJob
+
+	// Error opening or uploading the file.
+	Err error
+
+	// Time taken by the upload.
+	Duration time.Duration
+
+	// Content fingerprint of the file, if SkipIngested is set.
+	Fingerprint string
+
+	// Job that already ingested the same content into the target table. The file is not uploaded.
+	PreviousJobID string
+
+	// Path of an identical file in the same run that was ingested. The file is not uploaded.
+	DuplicateOf string
+
+	// Error recording the fingerprint in FingerprintStore. The file was still ingested.
+	RecordErr error
+}
+
+// alreadyIngested reports whether the file was skipped because its content was ingested already.
+func (file *LocalFileIngestionResult) alreadyIngested() bool {
+	return file.PreviousJobID != "" || file.DuplicateOf != ""
+}
+
+// LocalFilesIngestionReport : Outcome of IngestLocalFiles.
+type LocalFilesIngestionReport struct {
+	// Results of the ingested files, ordered by path.
+	Files []LocalFileIngestionResult
+
+	// Matched files skipped because their extension is not a supported file type.
+	Skipped []string
+
+	// Number of files uploaded successfully.
+	Succeeded int64
+
+	// Number of files not uploaded because their content was ingested already.
+	AlreadyIngested int64
+
+	// Number of files that failed.
+	Failed int64
+}
+
+// IngestLocalFiles : Ingest every CSV, Parquet and JSON file in a directory or matching a glob
+// Each file is uploaded with CreateIngestionJobsLocalFiles as its own job into TargetTable, at most Concurrency at a
+// time. Job IDs are JobIDPrefix, the file name and a random suffix. A line is written to Output as each file
+// finishes. Failures of individual files are recorded in the report rather than returned.
+//
+// With SkipIngested, files are fingerprinted with FingerprintLocalFile and a file is not uploaded if its content was
+// ingested into TargetTable before, as recorded in FingerprintStore and not reported as failed by ListIngestionJobs,
+// or if an identical file earlier in the run was ingested; if that file fails, the next identical file is uploaded
+// in its place. Uploaded files are recorded in the store; a file that was uploaded but could not be recorded still
+// counts as succeeded, with the store error in RecordErr.
+func (watsonxData *WatsonxDataV2) IngestLocalFiles(ingestLocalFilesOptions *IngestLocalFilesOptions) (result *LocalFilesIngestionReport, err error) {
+	result, err = watsonxData.IngestLocalFilesWithContext(context.Background(), ingestLocalFilesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// IngestLocalFilesWithContext is an alternate form of the IngestLocalFiles method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) IngestLocalFilesWithContext(ctx context.Context, ingestLocalFilesOptions *IngestLocalFilesOptions) (result *LocalFilesIngestionReport, err error) {
+	err = core.ValidateNotNil(ingestLocalFilesOptions, "ingestLocalFilesOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(ingestLocalFilesOptions, "ingestLocalFilesOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := ingestLocalFilesOptions
+
+	paths, err := matchLocalIngestionFiles(*opts.Source)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("cannot list '%s': %s", *opts.Source, err.Error()), "source-files-error", common.GetComponentInfo())
+		return
+	}
+	if len(paths) == 0 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("no files match '%s'", *opts.Source), "no-source-files", common.GetComponentInfo())
+		return
+	}
+
+	result = new(LocalFilesIngestionReport)
+	for _, path := range paths {
+		if _, ok := localIngestionFileTypes[strings.ToLower(filepath.Ext(path))]; ok {
+			result.Files = append(result.Files, LocalFileIngestionResult{
+				Path: path,
+			})
+		} else {
+			result.Skipped = append(result.Skipped, path)
+		}
+	}
+
+	var store IngestionFingerprintStore
+	if opts.SkipIngested != nil && *opts.SkipIngested {
+		store = opts.FingerprintStore
+		if store == nil {
+			store = NewLocalIngestionFingerprintStore(DefaultIngestionFingerprintStorePath())
+		}
+		err = watsonxData.findIngestedLocalFiles(ctx, opts, store, result.Files)
+		if err != nil {
+			result = nil
+			return
+		}
+	}
+
+	concurrency := int64(defaultLocalFilesIngestionConcurrency)
+	if opts.Concurrency != nil && *opts.Concurrency > 0 {
+		concurrency = *opts.Concurrency
+	}
+	var outputMutex sync.Mutex
+	report := func(file *LocalFileIngestionResult) {
+		if opts.Output == nil {
+			return
+		}
+		outputMutex.Lock()
+		defer outputMutex.Unlock()
+		if file.PreviousJobID != "" {
+			fmt.Fprintf(opts.Output, "skipped %s: already ingested by job %s\n", file.Path, file.PreviousJobID)
+		} else if file.DuplicateOf != "" {
+			fmt.Fprintf(opts.Output, "skipped %s: same content as %s\n", file.Path, file.DuplicateOf)
+		} else if file.Err != nil {
+			fmt.Fprintf(opts.Output, "failed %s: %s\n", file.Path, file.Err.Error())
+		} else {
+			fmt.Fprintf(opts.Output, "submitted %s as job %s (%s)\n", file.Path, file.JobID, file.Duration.Round(time.Millisecond))
+			if file.RecordErr != nil {
+				fmt.Fprintf(opts.Output, "warning %s: cannot record fingerprint: %s\n", file.Path, file.RecordErr.Error())
+			}
+		}
+	}
+	slots := make(chan struct{}, concurrency)
+	upload := func(file *LocalFileIngestionResult) {
+		select {
+		case slots <- struct{}{}:
+			watsonxData.ingestLocalFile(ctx, opts, file)
+			<-slots
+		case <-ctx.Done():
+			file.Err = ctx.Err()
+		}
+		if file.Err == nil && store != nil {
+			file.RecordErr = store.Record(*opts.TargetTable, file.Fingerprint, file.JobID)
+		}
+	}
+
+	// Copies of the same content wait for the first one: they are skipped if it succeeds and take its place, one at
+	// a time, if it fails.
+	var duplicates []*LocalFileIngestionResult
+	var wg sync.WaitGroup
+	for i := range result.Files {
+		file := &result.Files[i]
+		if file.DuplicateOf != "" {
+			duplicates = append(duplicates, file)
+			continue
+		}
+		wg.Add(1)
+		go func(file *LocalFileIngestionResult) {
+			defer wg.Done()
+			// Files skipped or failed while fingerprinting are not uploaded.
+			if !file.alreadyIngested() && file.Err == nil {
+				upload(file)
+			}
+			report(file)
+		}(file)
+	}
+	wg.Wait()
+	holders := map[string]*LocalFileIngestionResult{}
+	for i := range result.Files {
+		if file := &result.Files[i]; file.DuplicateOf == "" && file.Fingerprint != "" && holders[file.Fingerprint] == nil {
+			holders[file.Fingerprint] = file
+		}
+	}
+	for _, file := range duplicates {
+		holder := holders[file.Fingerprint]
+		if holder.Err == nil {
+			file.DuplicateOf = holder.Path
+		} else {
+			file.DuplicateOf = ""
+			upload(file)
+			if file.Err == nil {
+				holders[file.Fingerprint] = file
+			}
+		}
+		report(file)
+	}
+
+	for _, file := range result.Files {
+		if file.alreadyIngested() {
+			result.AlreadyIngested++
+		} else if file.Err != nil {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+	}
+	return
+}
+
+// ingestLocalFile uploads one file and records the outcome in file.
+func (watsonxData *WatsonxDataV2) ingestLocalFile(ctx context.Context, opts *IngestLocalFilesOptions, file *LocalFileIngestionResult) {
+	start := time.Now()
+	defer func() {
+		file.Duration = time.Since(start)
+	}()
+
+	types := localIngestionFileTypes[strings.ToLower(filepath.Ext(file.Path))]
+	file.SourceFileType = types[0]
+	file.JobID, file.Err = newLocalIngestionJobID(opts.JobIDPrefix, file.Path)
+	if file.Err != nil {
+		return
+	}
+	source, err := os.Open(file.Path)
+	if err != nil {
+		file.Err = core.SDKErrorf(err, "", "source-file-error", common.GetComponentInfo())
+		return
+	}
+	defer source.Close()
+
+	file.Job, _, file.Err = watsonxData.CreateIngestionJobsLocalFilesWithContext(ctx, &CreateIngestionJobsLocalFilesOptions{
+		AuthInstanceID:            opts.AuthInstanceID,
+		SourceDataFile:            source,
+		TargetTable:               opts.TargetTable,
+		JobID:                     core.StringPtr(file.JobID),
+		Username:                  opts.Username,
+		SourceDataFileContentType: core.StringPtr(types[1]),
+		SourceFileType:            core.StringPtr(types[0]),
+		CsvProperty:               opts.CsvProperty,
+		CreateIfNotExist:          opts.CreateIfNotExist,
+		ValidateCsvHeader:         opts.ValidateCsvHeader,
+		ExecuteConfig:             opts.ExecuteConfig,
+		EngineID:                  opts.EngineID,
+		Headers:                   opts.Headers,
+	})
+}
+
+// findIngestedLocalFiles fingerprints the files and marks those whose content was ingested already.
+func (watsonxData *WatsonxDataV2) findIngestedLocalFiles(ctx context.Context, opts *IngestLocalFilesOptions, store IngestionFingerprintStore, files []LocalFileIngestionResult) error {
+	history, err := watsonxData.listIngestionJobHistory(ctx, opts.AuthInstanceID, opts.Headers)
+	if err != nil {
+		return err
+	}
+	seen := map[string]string{}
+	for i := range files {
+		file := &files[i]
+		file.Fingerprint, file.Err = FingerprintLocalFile(file.Path)
+		if file.Err != nil {
+			continue
+		}
+		if path, ok := seen[file.Fingerprint]; ok {
+			file.DuplicateOf = path
+			continue
+		}
+		seen[file.Fingerprint] = file.Path
+		previousJobID, ingested, err := history.ingested(store, *opts.TargetTable, file.Fingerprint)
+		if err != nil {
+			return err
+		}
+		if ingested {
+			file.PreviousJobID = previousJobID
+		}
+	}
+	return nil
+}
+
+// matchLocalIngestionFiles returns the sorted regular files in source if it is a directory, or matching it as a
+// glob otherwise.
+func matchLocalIngestionFiles(source string) (paths []string, err error) {
+	pattern := source
+	if info, statErr := os.Stat(source); statErr == nil && info.IsDir() {
+		pattern = filepath.Join(source, "*")
+	}
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return
+	}
+	for _, match := range matches {
+		info, statErr := os.Stat(match)
+		if statErr == nil && info.Mode().IsRegular() {
+			paths = append(paths, match)
+		}
+	}
+	sort.Strings(paths)
+	return
+}
+
+// newLocalIngestionJobID returns prefix-<file name>-<random hex>, with unsafe characters of the file name replaced.
+func newLocalIngestionJobID(prefix *string, path string) (string, error) {
+	suffix := make([]byte, 4)
+	if _, err := io.ReadFull(rand.Reader, suffix); err != nil {
+		return "", core.SDKErrorf(err, "", "job-id-error", common.GetComponentInfo())
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	name = strings.Trim(jobIDUnsafe.ReplaceAllString(name, "-"), "-")
+	id := name + "-" + hex.EncodeToString(suffix)
+	if prefix != nil && *prefix != "" {
+		id = *prefix + "-" + id
+	}
+	return id, nil
+}
+
+// IngestLocalFilesOptions : The IngestLocalFiles options.
+type IngestLocalFilesOptions struct {
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId" validate:"required"`
+
+	// Directory whose files are ingested, or a glob pattern as in filepath.Match.
+	Source *string `json:"source" validate:"required,ne="`
+
+	// Target table name in format catalog.schema.table.
+	TargetTable *string `json:"target_table" validate:"required"`
+
+	// User submitting ingestion job.
+	Username *string `json:"username" validate:"required"`
+
+	// Prefix of the generated job IDs.
+	JobIDPrefix *string `json:"job_id_prefix,omitempty"`
+
+	// Maximum number of files uploaded at the same time. Defaults to 4.
+	Concurrency *int64 `json:"concurrency,omitempty"`
+
+	// Ingestion CSV properties (base64 encoding of a stringifed json).
+	CsvProperty *string `json:"csv_property,omitempty"`
+
+	// Create new target table (if true); Insert into pre-existing target table (if false).
+	CreateIfNotExist *bool `json:"create_if_not_exist,omitempty"`
+
+	// Validate CSV header if the target table exist.
+	ValidateCsvHeader *bool `json:"validate_csv_header,omitempty"`
+
+	// Ingestion engine configuration (base64 encoding of a stringifed json).
+	ExecuteConfig *string `json:"execute_config,omitempty"`
+
+	// ID of the spark engine to be used for ingestion.
+	EngineID *string `json:"engine_id,omitempty"`
+
+	// Skip files whose content was already ingested into the target table.
+	SkipIngested *bool `json:"skip_ingested,omitempty"`
+
+	// Store of the ingested fingerprints, used with SkipIngested. Defaults to a LocalIngestionFingerprintStore at
+	// DefaultIngestionFingerprintStorePath.
+	FingerprintStore IngestionFingerprintStore `json:"-"`
+
+	// Destination of a progress line per file.
+	Output io.Writer `json:"-"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewIngestLocalFilesOptions : Instantiate IngestLocalFilesOptions
+func (*WatsonxDataV2) NewIngestLocalFilesOptions(authInstanceID string, source string, targetTable string, username string) *IngestLocalFilesOptions {
+	return &IngestLocalFilesOptions{
+		AuthInstanceID: core.StringPtr(authInstanceID),
+		Source:         core.StringPtr(source),
+		TargetTable:    core.StringPtr(targetTable),
+		Username:       core.StringPtr(username),
+	}
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *IngestLocalFilesOptions) SetAuthInstanceID(authInstanceID string) *IngestLocalFilesOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetSource : Allow user to set Source
+func (_options *IngestLocalFilesOptions) SetSource(source string) *IngestLocalFilesOptions {
+	_options.Source = core.StringPtr(source)
+	return _options
+}
+
+// SetTargetTable : Allow user to set TargetTable
+func (_options *IngestLocalFilesOptions) SetTargetTable(targetTable string) *IngestLocalFilesOptions {
+	_options.TargetTable = core.StringPtr(targetTable)
+	return _options
+}
+
+// SetUsername : Allow user to set Username
+func (_options *IngestLocalFilesOptions) SetUsername(username string) *IngestLocalFilesOptions {
+	_options.Username = core.StringPtr(username)
+	return _options
+}
+
+// SetJobIDPrefix : Allow user to set JobIDPrefix
+func (_options *IngestLocalFilesOptions) SetJobIDPrefix(jobIDPrefix string) *IngestLocalFilesOptions {
+	_options.JobIDPrefix = core.StringPtr(jobIDPrefix)
+	return _options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *IngestLocalFilesOptions) SetConcurrency(concurrency int64) *IngestLocalFilesOptions {
+	_options.Concurrency = core.Int64Ptr(concurrency)
+	return _options
+}
+
+// SetCsvProperty : Allow user to set CsvProperty
+func (_options *IngestLocalFilesOptions) SetCsvProperty(csvProperty string) *IngestLocalFilesOptions {
+	_options.CsvProperty = core.StringPtr(csvProperty)
+	return _options
+}
+
+// SetCreateIfNotExist : Allow user to set CreateIfNotExist
+func (_options *IngestLocalFilesOptions) SetCreateIfNotExist(createIfNotExist bool) *IngestLocalFilesOptions {
+	_options.CreateIfNotExist = core.BoolPtr(createIfNotExist)
+	return _options
+}
+
+// SetValidateCsvHeader : Allow user to set ValidateCsvHeader
+func (_options *IngestLocalFilesOptions) SetValidateCsvHeader(validateCsvHeader bool) *IngestLocalFilesOptions {
+	_options.ValidateCsvHeader = core.BoolPtr(validateCsvHeader)
+	return _options
+}
+
+// SetExecuteConfig : Allow user to set ExecuteConfig
+func (_options *IngestLocalFilesOptions) SetExecuteConfig(executeConfig string) *IngestLocalFilesOptions {
+	_options.ExecuteConfig = core.StringPtr(executeConfig)
+	return _options
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *IngestLocalFilesOptions) SetEngineID(engineID string) *IngestLocalFilesOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetSkipIngested : Allow user to set SkipIngested
+func (_options *IngestLocalFilesOptions) SetSkipIngested(skipIngested bool) *IngestLocalFilesOptions {
+	_options.SkipIngested = core.BoolPtr(skipIngested)
+	return _options
+}
+
+// SetFingerprintStore : Allow user to set FingerprintStore
+func (_options *IngestLocalFilesOptions) SetFingerprintStore(fingerprintStore IngestionFingerprintStore) *IngestLocalFilesOptions {
+	_options.FingerprintStore = fingerprintStore
+	return _options
+}
+
+// SetOutput : Allow user to set Output
+func (_options *IngestLocalFilesOptions) SetOutput(output io.Writer) *IngestLocalFilesOptions {
+	_options.Output = output
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *IngestLocalFilesOptions) SetHeaders(param map[string]string) *IngestLocalFilesOptions {
+	options.Headers = param
+	return options
+}
@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 local files ingestion`, func() {
+	var testServer *httptest.Server
+	var mutex sync.Mutex
+	var uploads map[string]string
+	var dir string
+	BeforeEach(func() {
+		uploads = map[string]string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.URL.EscapedPath()).To(Equal("/ingestion_jobs_local_files"))
+			Expect(req.Header["Authinstanceid"]).To(Equal([]string{"crn:1"}))
+			Expect(req.ParseMultipartForm(1 << 20)).To(Succeed())
+			Expect(req.FormValue("target_table")).To(Equal("iceberg_data.sales.orders"))
+			Expect(req.FormValue("username")).To(Equal("ibmlhadmin"))
+			Expect(req.FormValue("create_if_not_exist")).To(Equal("true"))
+			file, _, err := req.FormFile("source_data_file")
+			Expect(err).To(BeNil())
+			content, err := io.ReadAll(file)
+			Expect(err).To(BeNil())
+
+			jobID := req.FormValue("job_id")
+			mutex.Lock()
+			uploads[jobID] = req.FormValue("source_file_type") + ":" + string(content)
+			mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			if strings.Contains(jobID, "broken") {
+				res.WriteHeader(400)
+				fmt.Fprint(res, `{"errors": [{"code": "bad_request", "message": "cannot parse file"}]}`)
+				return
+			}
+			res.WriteHeader(202)
+			fmt.Fprintf(res, `{"job_id": "%s", "status": "running"}`, jobID)
+		}))
+
+		var err error
+		dir, err = os.MkdirTemp("", "ingestion")
+		Expect(err).To(BeNil())
+		for name, content := range map[string]string{
+			"2025-06 orders.csv": "id,amount\n1,10\n",
+			"broken.csv":         "id,amount\n\"\n",
+			"orders.parquet":     "PAR1",
+			"orders.JSON":        `{"id": 1}`,
+			"README.txt":         "not data",
+		} {
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0600)).To(Succeed())
+		}
+		Expect(os.Mkdir(filepath.Join(dir, "archive.csv"), 0700)).To(Succeed())
+	})
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(dir)
+	})
+	Describe(`IngestLocalFiles(ingestLocalFilesOptions *IngestLocalFilesOptions)`, func() {
+		It(`Ingest every supported file in a directory`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			report, operationErr := watsonxDataService.IngestLocalFiles(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(report).To(BeNil())
+
+			output := new(bytes.Buffer)
+			ingestLocalFilesOptionsModel := watsonxDataService.NewIngestLocalFilesOptions("crn:1", dir, "iceberg_data.sales.orders", "ibmlhadmin")
+			ingestLocalFilesOptionsModel.SetJobIDPrefix("nightly")
+			ingestLocalFilesOptionsModel.SetConcurrency(2)
+			ingestLocalFilesOptionsModel.SetCreateIfNotExist(true)
+			ingestLocalFilesOptionsModel.SetOutput(output)
+			report, operationErr = watsonxDataService.IngestLocalFiles(ingestLocalFilesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(report.Skipped).To(Equal([]string{filepath.Join(dir, "README.txt")}))
+			Expect(report.Files).To(HaveLen(4))
+			Expect(report.Succeeded).To(Equal(int64(3)))
+			Expect(report.Failed).To(Equal(int64(1)))
+
+			Expect(report.Files[0].Path).To(Equal(filepath.Join(dir, "2025-06 orders.csv")))
+			Expect(report.Files[0].JobID).To(MatchRegexp(`^nightly-2025-06-orders-[0-9a-f]{8}$`))
+			Expect(report.Files[0].Job.JobID).To(Equal(core.StringPtr(report.Files[0].JobID)))
+			Expect(uploads[report.Files[0].JobID]).To(Equal("csv:id,amount\n1,10\n"))
+			Expect(report.Files[1].Err).ToNot(BeNil())
+			Expect(report.Files[2].SourceFileType).To(Equal("json"))
+			Expect(uploads[report.Files[3].JobID]).To(Equal("parquet:PAR1"))
+
+			Expect(strings.Split(strings.TrimSpace(output.String()), "\n")).To(HaveLen(4))
+			Expect(output.String()).To(ContainSubstring("failed " + filepath.Join(dir, "broken.csv")))
+		})
+		It(`Ingest files matching a glob`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			ingestLocalFilesOptionsModel := watsonxDataService.NewIngestLocalFilesOptions("crn:1", filepath.Join(dir, "orders.*"), "iceberg_data.sales.orders", "ibmlhadmin")
+			ingestLocalFilesOptionsModel.SetCreateIfNotExist(true)
+			report, operationErr := watsonxDataService.IngestLocalFiles(ingestLocalFilesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(report.Succeeded).To(Equal(int64(2)))
+			Expect(report.Files[0].JobID).To(MatchRegexp(`^orders-[0-9a-f]{8}$`))
+
+			ingestLocalFilesOptionsModel.SetSource(filepath.Join(dir, "*.xlsx"))
+			_, operationErr = watsonxDataService.IngestLocalFiles(ingestLocalFilesOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("no files match"))
+		})
+	})
+})
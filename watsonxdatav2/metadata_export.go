@@ -0,0 +1,515 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// jsonSchemaDraft is the JSON Schema dialect written by TableMetadata.JSONSchema.
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// avroNameUnsafe matches the characters replaced in Avro names.
+var avroNameUnsafe = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// yamlPlain matches strings written to YAML without quotes.
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// yamlReserved are plain scalars that YAML reads as booleans or null.
+var yamlReserved = []string{"y", "yes", "n", "no", "true", "false", "on", "off", "null"}
+
+// ColumnMetadata : A column of a table, as exported by TableMetadata.
+type ColumnMetadata struct {
+	// Column name.
+	Name string `json:"name"`
+
+	// Presto type in lower case, for example "decimal(10,2)" or "array(varchar)".
+	Type string `json:"type"`
+
+	// Maximum length of character types.
+	Length *int64 `json:"length,omitempty"`
+
+	// Precision of decimal types.
+	Precision *int64 `json:"precision,omitempty"`
+
+	// Scale of decimal types.
+	Scale *int64 `json:"scale,omitempty"`
+
+	// Column comment.
+	Comment string `json:"comment,omitempty"`
+}
+
+// TableMetadata : The columns of a table, ready to export.
+type TableMetadata struct {
+	// Catalog name.
+	Catalog string `json:"catalog"`
+
+	// Schema name.
+	Schema string `json:"schema"`
+
+	// Table name.
+	Table string `json:"table"`
+
+	// Columns in table order.
+	Columns []ColumnMetadata `json:"columns"`
+}
+
+// NewTableMetadata : Instantiate TableMetadata from the columns returned by ListColumns
+// Length, precision and scale are taken from the column, or from the type arguments, as in varchar(20) or
+// decimal(10,2), when the column does not have them.
+func NewTableMetadata(table CatalogMetadataPath, columns []Column) *TableMetadata {
+	metadata := &TableMetadata{
+		Catalog: table.Catalog,
+		Schema:  table.Schema,
+		Table:   table.Table,
+		Columns: make([]ColumnMetadata, 0, len(columns)),
+	}
+	for _, column := range columns {
+		columnMetadata := newColumnMetadata(core.StringNilMapper(column.ColumnName), core.StringNilMapper(column.Type))
+		columnMetadata.Comment = core.StringNilMapper(column.Comment)
+		for _, attribute := range []struct {
+			value  *string
+			target **int64
+		}{
+			{column.Length, &columnMetadata.Length},
+			{column.Precision, &columnMetadata.Precision},
+			{column.Scale, &columnMetadata.Scale},
+		} {
+			if attribute.value == nil {
+				continue
+			}
+			if value, err := strconv.ParseInt(strings.TrimSpace(*attribute.value), 10, 64); err == nil {
+				*attribute.target = &value
+			}
+		}
+		metadata.Columns = append(metadata.Columns, columnMetadata)
+	}
+	return metadata
+}
+
+// NewTableMetadataFromDetail : Instantiate TableMetadata from a table returned by GetTableDetails or GetAllColumns
+// Columns are ordered by index.
+func NewTableMetadataFromDetail(detail *TableColumDetail) *TableMetadata {
+	items := append([]TableColumDetailColumnsItems(nil), detail.Columns...)
+	sort.SliceStable(items, func(i, j int) bool {
+		return columnItemIndex(items[i]) < columnItemIndex(items[j])
+	})
+	metadata := &TableMetadata{
+		Catalog: core.StringNilMapper(detail.Catalog),
+		Schema:  core.StringNilMapper(detail.Schema),
+		Table:   core.StringNilMapper(detail.Table),
+		Columns: make([]ColumnMetadata, 0, len(items)),
+	}
+	for _, item := range items {
+		metadata.Columns = append(metadata.Columns, newColumnMetadata(core.StringNilMapper(item.Column), core.StringNilMapper(item.Type)))
+	}
+	return metadata
+}
+
+// columnItemIndex returns the index of a column item, or 0 when it has none.
+func columnItemIndex(item TableColumDetailColumnsItems) int64 {
+	if item.Index == nil {
+		return 0
+	}
+	return *item.Index
+}
+
+// newColumnMetadata returns the metadata of a column with length, precision and scale taken from the type.
+func newColumnMetadata(name string, columnType string) ColumnMetadata {
+	columnMetadata := ColumnMetadata{
+		Name: name,
+		Type: strings.ToLower(strings.TrimSpace(columnType)),
+	}
+	parsed := parsePrestoType(columnMetadata.Type)
+	argument := func(i int) *int64 {
+		if i >= len(parsed.args) {
+			return nil
+		}
+		value, err := strconv.ParseInt(parsed.args[i], 10, 64)
+		if err != nil {
+			return nil
+		}
+		return &value
+	}
+	switch parsed.base {
+	case "varchar", "char":
+		columnMetadata.Length = argument(0)
+	case "decimal":
+		columnMetadata.Precision = argument(0)
+		columnMetadata.Scale = argument(1)
+	}
+	return columnMetadata
+}
+
+// prestoType is a Presto type split into its base name and top-level arguments.
+type prestoType struct {
+	base string
+	args []string
+}
+
+// parsePrestoType splits a type such as map(varchar, array(bigint)) into its base and top-level arguments.
+func parsePrestoType(columnType string) (parsed prestoType) {
+	columnType = strings.TrimSpace(columnType)
+	open := strings.IndexByte(columnType, '(')
+	if open < 0 || !strings.HasSuffix(columnType, ")") {
+		parsed.base = columnType
+		return
+	}
+	parsed.base = strings.TrimSpace(columnType[:open])
+	depth, start := 0, open+1
+	for i := open + 1; i < len(columnType)-1; i++ {
+		switch columnType[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parsed.args = append(parsed.args, strings.TrimSpace(columnType[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	parsed.args = append(parsed.args, strings.TrimSpace(columnType[start:len(columnType)-1]))
+	return
+}
+
+// rowField splits a row field such as "name varchar" into its name and type.
+func rowField(field string) (name string, fieldType string) {
+	name, fieldType, _ = strings.Cut(strings.TrimSpace(field), " ")
+	return strings.Trim(name, `"`), strings.TrimSpace(fieldType)
+}
+
+// JSONSchema : Return a JSON Schema (draft 2020-12) of an object holding one row of the table
+// Every column is optional and nullable, because the metadata does not say otherwise. Character lengths, decimal
+// precision and scale, dates and timestamps become maxLength, bounds, multipleOf and format keywords.
+func (table *TableMetadata) JSONSchema() map[string]interface{} {
+	properties := map[string]interface{}{}
+	for _, column := range table.Columns {
+		property := jsonSchemaType(column.Type, &column)
+		if column.Comment != "" {
+			property["description"] = column.Comment
+		}
+		properties[column.Name] = property
+	}
+	return map[string]interface{}{
+		"$schema":    jsonSchemaDraft,
+		"title":      CatalogMetadataPath{Catalog: table.Catalog, Schema: table.Schema, Table: table.Table}.String(),
+		"type":       "object",
+		"properties": properties,
+	}
+}
+
+// jsonSchemaType returns the nullable JSON Schema of a Presto type. column is set for top-level columns, whose
+// length, precision and scale may come from the column rather than the type.
+func jsonSchemaType(columnType string, column *ColumnMetadata) map[string]interface{} {
+	parsed := parsePrestoType(columnType)
+	if column == nil {
+		column = &ColumnMetadata{}
+		*column = newColumnMetadata("", columnType)
+	}
+	nullable := func(jsonType string) []string {
+		return []string{jsonType, "null"}
+	}
+	switch parsed.base {
+	case "tinyint", "smallint", "integer", "int", "bigint":
+		return map[string]interface{}{"type": nullable("integer")}
+	case "real", "double":
+		return map[string]interface{}{"type": nullable("number")}
+	case "decimal":
+		schema := map[string]interface{}{"type": nullable("number")}
+		if column.Precision != nil && column.Scale != nil {
+			bound := math.Pow10(int(*column.Precision - *column.Scale))
+			schema["exclusiveMaximum"] = bound
+			schema["exclusiveMinimum"] = -bound
+		}
+		if column.Scale != nil && *column.Scale > 0 {
+			schema["multipleOf"] = math.Pow10(-int(*column.Scale))
+		}
+		return schema
+	case "boolean":
+		return map[string]interface{}{"type": nullable("boolean")}
+	case "varchar", "char":
+		schema := map[string]interface{}{"type": nullable("string")}
+		if column.Length != nil {
+			schema["maxLength"] = *column.Length
+		}
+		return schema
+	case "date":
+		return map[string]interface{}{"type": nullable("string"), "format": "date"}
+	case "timestamp", "timestamp with time zone":
+		return map[string]interface{}{"type": nullable("string"), "format": "date-time"}
+	case "time", "time with time zone":
+		return map[string]interface{}{"type": nullable("string"), "format": "time"}
+	case "uuid":
+		return map[string]interface{}{"type": nullable("string"), "format": "uuid"}
+	case "varbinary":
+		return map[string]interface{}{"type": nullable("string"), "contentEncoding": "base64"}
+	case "array":
+		if len(parsed.args) == 1 {
+			return map[string]interface{}{"type": nullable("array"), "items": jsonSchemaType(parsed.args[0], nil)}
+		}
+	case "map":
+		if len(parsed.args) == 2 {
+			return map[string]interface{}{"type": nullable("object"), "additionalProperties": jsonSchemaType(parsed.args[1], nil)}
+		}
+	case "row":
+		properties := map[string]interface{}{}
+		for _, field := range parsed.args {
+			name, fieldType := rowField(field)
+			properties[name] = jsonSchemaType(fieldType, nil)
+		}
+		return map[string]interface{}{"type": nullable("object"), "properties": properties}
+	}
+	// Any value: json and types not known here.
+	return map[string]interface{}{}
+}
+
+// WriteJSONSchema writes the JSON Schema of the table to writer.
+func (table *TableMetadata) WriteJSONSchema(writer io.Writer) error {
+	return writeMetadataJSON(writer, table.JSONSchema(), "json-schema-error")
+}
+
+// avroRecord is an Avro record schema, with fields in order.
+type avroRecord struct {
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace,omitempty"`
+	Doc       string      `json:"doc,omitempty"`
+	Fields    []avroField `json:"fields"`
+}
+
+// avroField is a field of an Avro record. Every field is nullable with a null default.
+type avroField struct {
+	Name    string      `json:"name"`
+	Type    interface{} `json:"type"`
+	Default interface{} `json:"default"`
+	Doc     string      `json:"doc,omitempty"`
+}
+
+// AvroSchema : Return an Avro record schema of one row of the table
+// The record is named after the table in the catalog.schema namespace. Every field is a union of null and the
+// column type, with a null default. Names are changed to valid Avro names by replacing other characters with
+// underscores; the original column name is kept in the field doc when it changes.
+func (table *TableMetadata) AvroSchema() interface{} {
+	record := avroRecord{
+		Type:      "record",
+		Name:      avroName(table.Table),
+		Namespace: avroNamespace(table.Catalog, table.Schema),
+		Fields:    make([]avroField, 0, len(table.Columns)),
+	}
+	for _, column := range table.Columns {
+		field := avroField{
+			Name: avroName(column.Name),
+			Type: []interface{}{"null", avroType(column.Type, &column, avroName(column.Name))},
+			Doc:  column.Comment,
+		}
+		if field.Name != column.Name {
+			field.Doc = strings.TrimSpace(fmt.Sprintf("column %s. %s", column.Name, column.Comment))
+		}
+		record.Fields = append(record.Fields, field)
+	}
+	return record
+}
+
+// avroName returns name with characters invalid in Avro names replaced and an underscore before a leading digit.
+func avroName(name string) string {
+	name = avroNameUnsafe.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// avroNamespace returns the dot-separated Avro names of the non-empty parts.
+func avroNamespace(parts ...string) string {
+	var names []string
+	for _, part := range parts {
+		if part != "" {
+			names = append(names, avroName(part))
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+// avroType returns the Avro schema of a Presto type. column is set for top-level columns. recordName names the
+// records of row types.
+func avroType(columnType string, column *ColumnMetadata, recordName string) interface{} {
+	parsed := parsePrestoType(columnType)
+	if column == nil {
+		column = &ColumnMetadata{}
+		*column = newColumnMetadata("", columnType)
+	}
+	switch parsed.base {
+	case "tinyint", "smallint", "integer", "int":
+		return "int"
+	case "bigint":
+		return "long"
+	case "real":
+		return "float"
+	case "double":
+		return "double"
+	case "boolean":
+		return "boolean"
+	case "varbinary":
+		return "bytes"
+	case "decimal":
+		precision, scale := int64(38), int64(0)
+		if column.Precision != nil {
+			precision = *column.Precision
+		}
+		if column.Scale != nil {
+			scale = *column.Scale
+		}
+		return map[string]interface{}{"type": "bytes", "logicalType": "decimal", "precision": precision, "scale": scale}
+	case "date":
+		return map[string]interface{}{"type": "int", "logicalType": "date"}
+	case "timestamp", "timestamp with time zone":
+		return map[string]interface{}{"type": "long", "logicalType": "timestamp-micros"}
+	case "time", "time with time zone":
+		return map[string]interface{}{"type": "long", "logicalType": "time-micros"}
+	case "uuid":
+		return map[string]interface{}{"type": "string", "logicalType": "uuid"}
+	case "array":
+		if len(parsed.args) == 1 {
+			return map[string]interface{}{"type": "array", "items": avroType(parsed.args[0], nil, recordName+"_item")}
+		}
+	case "map":
+		if len(parsed.args) == 2 {
+			return map[string]interface{}{"type": "map", "values": avroType(parsed.args[1], nil, recordName+"_value")}
+		}
+	case "row":
+		record := avroRecord{
+			Type:   "record",
+			Name:   recordName + "_record",
+			Fields: make([]avroField, 0, len(parsed.args)),
+		}
+		for _, field := range parsed.args {
+			name, fieldType := rowField(field)
+			record.Fields = append(record.Fields, avroField{
+				Name: avroName(name),
+				Type: []interface{}{"null", avroType(fieldType, nil, recordName+"_"+avroName(name))},
+			})
+		}
+		return record
+	}
+	// Character types, json and types not known here.
+	return "string"
+}
+
+// WriteAvroSchema writes the Avro schema of the table to writer.
+func (table *TableMetadata) WriteAvroSchema(writer io.Writer) error {
+	return writeMetadataJSON(writer, table.AvroSchema(), "avro-schema-error")
+}
+
+// writeMetadataJSON writes value to writer as indented JSON without HTML escaping.
+func writeMetadataJSON(writer io.Writer, value interface{}, errorCode string) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return core.SDKErrorf(err, "", errorCode, common.GetComponentInfo())
+	}
+	return nil
+}
+
+// WriteDbtSources : Write a dbt sources.yml describing the tables
+// Tables are grouped into one source per catalog and schema, in order of first appearance. A source is named after
+// its schema, or catalog_schema when two catalogs have a schema with the same name, and has the catalog as
+// database. Columns have their Presto type as data_type and their comment as description.
+func WriteDbtSources(writer io.Writer, tables []*TableMetadata) error {
+	type source struct {
+		catalog string
+		schema  string
+		tables  []*TableMetadata
+	}
+	var sources []*source
+	bySchema := map[[2]string]*source{}
+	catalogsOfSchema := map[string]map[string]bool{}
+	for _, table := range tables {
+		key := [2]string{table.Catalog, table.Schema}
+		if bySchema[key] == nil {
+			bySchema[key] = &source{catalog: table.Catalog, schema: table.Schema}
+			sources = append(sources, bySchema[key])
+			if catalogsOfSchema[table.Schema] == nil {
+				catalogsOfSchema[table.Schema] = map[string]bool{}
+			}
+			catalogsOfSchema[table.Schema][table.Catalog] = true
+		}
+		bySchema[key].tables = append(bySchema[key].tables, table)
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("version: 2\n\n")
+	if len(sources) == 0 {
+		buffer.WriteString("sources: []\n")
+	} else {
+		buffer.WriteString("sources:\n")
+	}
+	for _, source := range sources {
+		name := source.schema
+		if len(catalogsOfSchema[source.schema]) > 1 {
+			name = source.catalog + "_" + source.schema
+		}
+		fmt.Fprintf(&buffer, "  - name: %s\n", yamlString(name))
+		fmt.Fprintf(&buffer, "    database: %s\n", yamlString(source.catalog))
+		fmt.Fprintf(&buffer, "    schema: %s\n", yamlString(source.schema))
+		buffer.WriteString("    tables:\n")
+		for _, table := range source.tables {
+			fmt.Fprintf(&buffer, "      - name: %s\n", yamlString(table.Table))
+			if len(table.Columns) == 0 {
+				continue
+			}
+			buffer.WriteString("        columns:\n")
+			for _, column := range table.Columns {
+				fmt.Fprintf(&buffer, "          - name: %s\n", yamlString(column.Name))
+				fmt.Fprintf(&buffer, "            data_type: %s\n", yamlString(column.Type))
+				if column.Comment != "" {
+					fmt.Fprintf(&buffer, "            description: %s\n", yamlString(column.Comment))
+				}
+			}
+		}
+	}
+	if _, err := buffer.WriteTo(writer); err != nil {
+		return core.SDKErrorf(err, "", "dbt-sources-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// yamlString returns value as a YAML scalar: plain for simple identifiers, double-quoted otherwise.
+func yamlString(value string) string {
+	if yamlPlain.MatchString(value) && !containsFold(yamlReserved, value) {
+		return value
+	}
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	// Strings always encode; a JSON string is a valid YAML double-quoted scalar.
+	encoder.Encode(value)
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
@@ -0,0 +1,228 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 metadata export`, func() {
+	var table *watsonxdatav2.TableMetadata
+	BeforeEach(func() {
+		table = watsonxdatav2.NewTableMetadata(
+			watsonxdatav2.CatalogMetadataPath{
+				Catalog: "iceberg_data",
+				Schema:  "sales",
+				Table:   "orders",
+			},
+			[]watsonxdatav2.Column{
+				{
+					ColumnName: core.StringPtr("id"),
+					Type:       core.StringPtr("BIGINT"),
+					Comment:    core.StringPtr("Order id"),
+				},
+				{
+					ColumnName: core.StringPtr("amount"),
+					Type:       core.StringPtr("decimal(10,2)"),
+				},
+				{
+					ColumnName: core.StringPtr("customer name"),
+					Type:       core.StringPtr("varchar"),
+					Length:     core.StringPtr("64"),
+					Comment:    core.StringPtr("Name: as given"),
+				},
+				{
+					ColumnName: core.StringPtr("ordered_at"),
+					Type:       core.StringPtr("timestamp"),
+				},
+				{
+					ColumnName: core.StringPtr("tags"),
+					Type:       core.StringPtr("array(varchar)"),
+				},
+			},
+		)
+	})
+	Describe(`NewTableMetadata(table CatalogMetadataPath, columns []Column)`, func() {
+		It(`Takes length, precision and scale from the column or the type`, func() {
+			Expect(table.Columns).To(HaveLen(5))
+			Expect(table.Columns[0].Type).To(Equal("bigint"))
+			Expect(*table.Columns[1].Precision).To(Equal(int64(10)))
+			Expect(*table.Columns[1].Scale).To(Equal(int64(2)))
+			Expect(*table.Columns[2].Length).To(Equal(int64(64)))
+			Expect(table.Columns[3].Length).To(BeNil())
+		})
+	})
+	Describe(`NewTableMetadataFromDetail(detail *TableColumDetail)`, func() {
+		It(`Orders columns by index`, func() {
+			metadata := watsonxdatav2.NewTableMetadataFromDetail(&watsonxdatav2.TableColumDetail{
+				Catalog: core.StringPtr("hive_data"),
+				Schema:  core.StringPtr("crm"),
+				Table:   core.StringPtr("people"),
+				Columns: []watsonxdatav2.TableColumDetailColumnsItems{
+					{
+						Column: core.StringPtr("name"),
+						Index:  core.Int64Ptr(2),
+						Type:   core.StringPtr("varchar(20)"),
+					},
+					{
+						Column: core.StringPtr("id"),
+						Index:  core.Int64Ptr(1),
+						Type:   core.StringPtr("integer"),
+					},
+				},
+			})
+			Expect(metadata.Catalog).To(Equal("hive_data"))
+			Expect(metadata.Columns[0].Name).To(Equal("id"))
+			Expect(metadata.Columns[1].Name).To(Equal("name"))
+			Expect(*metadata.Columns[1].Length).To(Equal(int64(20)))
+		})
+	})
+	Describe(`WriteJSONSchema(writer io.Writer)`, func() {
+		It(`Maps Presto types to JSON Schema types`, func() {
+			var buffer bytes.Buffer
+			Expect(table.WriteJSONSchema(&buffer)).To(Succeed())
+			var schema map[string]interface{}
+			Expect(json.Unmarshal(buffer.Bytes(), &schema)).To(Succeed())
+			Expect(schema["$schema"]).To(Equal("https://json-schema.org/draft/2020-12/schema"))
+			Expect(schema["title"]).To(Equal("iceberg_data.sales.orders"))
+			properties := schema["properties"].(map[string]interface{})
+			Expect(properties["id"]).To(Equal(map[string]interface{}{
+				"type":        []interface{}{"integer", "null"},
+				"description": "Order id",
+			}))
+			Expect(properties["amount"]).To(Equal(map[string]interface{}{
+				"type":             []interface{}{"number", "null"},
+				"exclusiveMaximum": float64(1e8),
+				"exclusiveMinimum": float64(-1e8),
+				"multipleOf":       0.01,
+			}))
+			Expect(properties["customer name"]).To(HaveKeyWithValue("maxLength", float64(64)))
+			Expect(properties["ordered_at"]).To(HaveKeyWithValue("format", "date-time"))
+			Expect(properties["tags"]).To(Equal(map[string]interface{}{
+				"type":  []interface{}{"array", "null"},
+				"items": map[string]interface{}{"type": []interface{}{"string", "null"}},
+			}))
+		})
+	})
+	Describe(`WriteAvroSchema(writer io.Writer)`, func() {
+		It(`Writes a record of nullable fields`, func() {
+			var buffer bytes.Buffer
+			Expect(table.WriteAvroSchema(&buffer)).To(Succeed())
+			var schema struct {
+				Type      string `json:"type"`
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+				Fields    []struct {
+					Name    string        `json:"name"`
+					Type    []interface{} `json:"type"`
+					Default interface{}   `json:"default"`
+					Doc     string        `json:"doc"`
+				} `json:"fields"`
+			}
+			Expect(json.Unmarshal(buffer.Bytes(), &schema)).To(Succeed())
+			Expect(buffer.String()).To(ContainSubstring(`"default": null`))
+			Expect(schema.Type).To(Equal("record"))
+			Expect(schema.Name).To(Equal("orders"))
+			Expect(schema.Namespace).To(Equal("iceberg_data.sales"))
+			Expect(schema.Fields).To(HaveLen(5))
+			Expect(schema.Fields[0].Type).To(Equal([]interface{}{"null", "long"}))
+			Expect(schema.Fields[0].Doc).To(Equal("Order id"))
+			Expect(schema.Fields[1].Type[1]).To(Equal(map[string]interface{}{
+				"type":        "bytes",
+				"logicalType": "decimal",
+				"precision":   float64(10),
+				"scale":       float64(2),
+			}))
+			Expect(schema.Fields[2].Name).To(Equal("customer_name"))
+			Expect(schema.Fields[2].Doc).To(Equal("column customer name. Name: as given"))
+			Expect(schema.Fields[3].Type[1]).To(HaveKeyWithValue("logicalType", "timestamp-micros"))
+			Expect(schema.Fields[4].Type[1]).To(Equal(map[string]interface{}{"type": "array", "items": "string"}))
+		})
+		It(`Names nested row records`, func() {
+			metadata := watsonxdatav2.NewTableMetadata(watsonxdatav2.CatalogMetadataPath{Table: "t"}, []watsonxdatav2.Column{
+				{
+					ColumnName: core.StringPtr("address"),
+					Type:       core.StringPtr("row(street varchar, zip integer)"),
+				},
+			})
+			schema, err := json.Marshal(metadata.AvroSchema())
+			Expect(err).To(BeNil())
+			Expect(string(schema)).To(ContainSubstring(`"name":"address_record"`))
+			Expect(string(schema)).To(ContainSubstring(`{"name":"zip","type":["null","int"],"default":null}`))
+		})
+	})
+	Describe(`WriteDbtSources(writer io.Writer, tables []*TableMetadata)`, func() {
+		It(`Groups tables by catalog and schema`, func() {
+			people := watsonxdatav2.NewTableMetadata(
+				watsonxdatav2.CatalogMetadataPath{
+					Catalog: "hive_data",
+					Schema:  "sales",
+					Table:   "people",
+				},
+				[]watsonxdatav2.Column{
+					{
+						ColumnName: core.StringPtr("on"),
+						Type:       core.StringPtr("boolean"),
+					},
+				},
+			)
+			var buffer bytes.Buffer
+			Expect(watsonxdatav2.WriteDbtSources(&buffer, []*watsonxdatav2.TableMetadata{table, people})).To(Succeed())
+			Expect(buffer.String()).To(Equal(`version: 2
+
+sources:
+  - name: iceberg_data_sales
+    database: iceberg_data
+    schema: sales
+    tables:
+      - name: orders
+        columns:
+          - name: id
+            data_type: bigint
+            description: "Order id"
+          - name: amount
+            data_type: "decimal(10,2)"
+          - name: "customer name"
+            data_type: varchar
+            description: "Name: as given"
+          - name: ordered_at
+            data_type: timestamp
+          - name: tags
+            data_type: "array(varchar)"
+  - name: hive_data_sales
+    database: hive_data
+    schema: sales
+    tables:
+      - name: people
+        columns:
+          - name: "on"
+            data_type: boolean
+`))
+		})
+		It(`Writes an empty source list`, func() {
+			var buffer bytes.Buffer
+			Expect(watsonxdatav2.WriteDbtSources(&buffer, nil)).To(Succeed())
+			Expect(buffer.String()).To(Equal("version: 2\n\nsources: []\n"))
+		})
+	})
+})
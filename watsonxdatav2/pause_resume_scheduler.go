@@ -0,0 +1,511 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// CronSchedule : A parsed five-field cron expression
+// The fields are minute (0-59), hour (0-23), day of month (1-31), month (1-12) and day of week (0-6, Sunday is 0 or
+// 7). Each field accepts *, single values, ranges (a-b), lists (a,b) and steps (*/n or a-b/n). As in cron, if both
+// day of month and day of week are restricted, a time matches if either matches.
+type CronSchedule struct {
+	spec          string
+	minutes       []bool
+	hours         []bool
+	daysOfMonth   []bool
+	months        []bool
+	daysOfWeek    []bool
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// ParseCronSchedule : Parse a five-field cron expression.
+func ParseCronSchedule(spec string) (schedule *CronSchedule, err error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		err = core.SDKErrorf(nil, fmt.Sprintf("cron expression '%s' must have 5 fields", spec), "invalid-cron-expression", common.GetComponentInfo())
+		return
+	}
+	schedule = &CronSchedule{
+		spec:          spec,
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	targets := []*[]bool{&schedule.minutes, &schedule.hours, &schedule.daysOfMonth, &schedule.months, &schedule.daysOfWeek}
+	for i, field := range fields {
+		*targets[i], err = parseCronField(field, bounds[i][0], bounds[i][1])
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("invalid cron expression '%s': %s", spec, err.Error()), "invalid-cron-expression", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	if schedule.daysOfWeek[7] {
+		schedule.daysOfWeek[0] = true
+	}
+	return
+}
+
+// parseCronField returns the values selected by one cron field, indexed by value.
+func parseCronField(field string, min int, max int) (values []bool, err error) {
+	values = make([]bool, max+1)
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if slash := strings.Index(part, "/"); slash >= 0 {
+			rangePart = part[:slash]
+			if step, err = strconv.Atoi(part[slash+1:]); err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step in '%s'", part)
+			}
+		}
+		low, high := min, max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			if low, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, fmt.Errorf("invalid value in '%s'", part)
+			}
+			high = low
+			if len(bounds) == 2 {
+				if high, err = strconv.Atoi(bounds[1]); err != nil {
+					return nil, fmt.Errorf("invalid value in '%s'", part)
+				}
+			} else if step > 1 {
+				high = max
+			}
+		}
+		if low < min || high > max || low > high {
+			return nil, fmt.Errorf("'%s' is outside %d-%d", part, min, max)
+		}
+		for value := low; value <= high; value += step {
+			values[value] = true
+		}
+	}
+	return
+}
+
+// String returns the cron expression.
+func (schedule *CronSchedule) String() string {
+	return schedule.spec
+}
+
+// Matches returns true if the minute of t is selected by the schedule.
+func (schedule *CronSchedule) Matches(t time.Time) bool {
+	if !schedule.minutes[t.Minute()] || !schedule.hours[t.Hour()] || !schedule.months[int(t.Month())] {
+		return false
+	}
+	dayOfMonth, dayOfWeek := schedule.daysOfMonth[t.Day()], schedule.daysOfWeek[int(t.Weekday())]
+	switch {
+	case schedule.anyDayOfMonth && schedule.anyDayOfWeek:
+		return true
+	case schedule.anyDayOfMonth:
+		return dayOfWeek
+	case schedule.anyDayOfWeek:
+		return dayOfMonth
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// Next returns the first minute after t selected by the schedule, or the zero time if there is none within five
+// years.
+func (schedule *CronSchedule) Next(t time.Time) time.Time {
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	for limit := next.AddDate(5, 0, 0); next.Before(limit); next = next.Add(time.Minute) {
+		if schedule.Matches(next) {
+			return next
+		}
+	}
+	return time.Time{}
+}
+
+// PauseResumePolicy : When to pause and resume one engine or Milvus service.
+type PauseResumePolicy struct {
+	// Type of the resource.
+	ResourceType *string `json:"resource_type" validate:"required"`
+
+	// Engine or service ID.
+	ResourceID *string `json:"resource_id" validate:"required,ne="`
+
+	// Cron expression of the times to pause the resource.
+	PauseSchedule *string `json:"pause_schedule,omitempty"`
+
+	// Cron expression of the times to resume the resource.
+	ResumeSchedule *string `json:"resume_schedule,omitempty"`
+}
+
+// Constants associated with the PauseResumePolicy.ResourceType property.
+// Type of the resource.
+const (
+	PauseResumePolicy_ResourceType_Milvus      = "milvus"
+	PauseResumePolicy_ResourceType_Prestissimo = "prestissimo"
+	PauseResumePolicy_ResourceType_Presto      = "presto"
+	PauseResumePolicy_ResourceType_Spark       = "spark"
+)
+
+// NewPauseResumePolicy : Instantiate PauseResumePolicy (Generic Model Constructor)
+func (*WatsonxDataV2) NewPauseResumePolicy(resourceType string, resourceID string) (_model *PauseResumePolicy, err error) {
+	_model = &PauseResumePolicy{
+		ResourceType: core.StringPtr(resourceType),
+		ResourceID:   core.StringPtr(resourceID),
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// PauseResumeAction : One pause or resume considered by a scheduler run.
+type PauseResumeAction struct {
+	// Type of the resource.
+	ResourceType string `json:"resource_type"`
+
+	// Engine or service ID.
+	ResourceID string `json:"resource_id"`
+
+	// "pause" or "resume".
+	Action string `json:"action"`
+
+	// Whether the call was made, skipped or failed.
+	Outcome string `json:"outcome"`
+
+	// Why the action was skipped, or the error if it failed.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Constants associated with the PauseResumeAction.Action property.
+// "pause" or "resume".
+const (
+	PauseResumeAction_Action_Pause  = "pause"
+	PauseResumeAction_Action_Resume = "resume"
+)
+
+// Constants associated with the PauseResumeAction.Outcome property.
+// Whether the call was made, skipped or failed.
+const (
+	PauseResumeAction_Outcome_Done    = "done"
+	PauseResumeAction_Outcome_Failed  = "failed"
+	PauseResumeAction_Outcome_Skipped = "skipped"
+)
+
+// PauseResumeAuditRecord : The actions taken by one scheduler run.
+type PauseResumeAuditRecord struct {
+	// The minute the run evaluated the schedules for.
+	RunAt time.Time `json:"run_at"`
+
+	// Actions due at RunAt. Empty if nothing was due.
+	Actions []PauseResumeAction `json:"actions"`
+}
+
+// PauseResumeScheduler : Pauses and resumes engines and Milvus services on cron schedules
+// Create a scheduler with NewPauseResumeScheduler and call Run from a long-lived process, or call RunOnce or
+// RunSince from an external scheduler. Resources tagged with the exclusion tag are never paused or resumed.
+type PauseResumeScheduler struct {
+	service   *WatsonxDataV2
+	options   *PauseResumeSchedulerOptions
+	policies  []PauseResumePolicy
+	schedules [][2]*CronSchedule
+	mutex     sync.Mutex
+}
+
+// NewPauseResumeScheduler : Instantiate PauseResumeScheduler
+// Every policy and its cron expressions are validated.
+func (watsonxData *WatsonxDataV2) NewPauseResumeScheduler(pauseResumeSchedulerOptions *PauseResumeSchedulerOptions) (scheduler *PauseResumeScheduler, err error) {
+	err = core.ValidateNotNil(pauseResumeSchedulerOptions, "pauseResumeSchedulerOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(pauseResumeSchedulerOptions, "pauseResumeSchedulerOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	scheduler = &PauseResumeScheduler{
+		service:  watsonxData,
+		options:  pauseResumeSchedulerOptions,
+		policies: append([]PauseResumePolicy(nil), pauseResumeSchedulerOptions.Policies...),
+	}
+	for i := range scheduler.policies {
+		policy := &scheduler.policies[i]
+		switch *policy.ResourceType {
+		case PauseResumePolicy_ResourceType_Milvus, PauseResumePolicy_ResourceType_Prestissimo, PauseResumePolicy_ResourceType_Presto, PauseResumePolicy_ResourceType_Spark:
+		default:
+			err = core.SDKErrorf(nil, fmt.Sprintf("unsupported resource type '%s'", *policy.ResourceType), "invalid-resource-type", common.GetComponentInfo())
+			return nil, err
+		}
+		var schedules [2]*CronSchedule
+		for j, spec := range []*string{policy.PauseSchedule, policy.ResumeSchedule} {
+			if spec == nil {
+				continue
+			}
+			if schedules[j], err = ParseCronSchedule(*spec); err != nil {
+				return nil, err
+			}
+		}
+		if schedules[0] == nil && schedules[1] == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("policy for %s '%s' has no schedule", *policy.ResourceType, *policy.ResourceID), "missing-schedule", common.GetComponentInfo())
+			return nil, err
+		}
+		scheduler.schedules = append(scheduler.schedules, schedules)
+	}
+	return
+}
+
+// Run evaluates the policies at the start of every minute until ctx is done, and returns the context error. Minutes
+// that pass while a run is slow are evaluated when it ends, so no schedule is skipped.
+func (scheduler *PauseResumeScheduler) Run(ctx context.Context) error {
+	last := time.Now().Truncate(time.Minute)
+	for {
+		timer := time.NewTimer(time.Until(last.Add(time.Minute)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+			if records := scheduler.RunSince(ctx, last, time.Now()); len(records) > 0 {
+				last = records[len(records)-1].RunAt
+			}
+		}
+	}
+}
+
+// RunSince calls RunOnce for every minute after the minute of last up to and including the minute of now, oldest
+// first, and returns their audit records. It stops early when ctx is done. An external scheduler that may run late
+// can pass the time of its previous run as last.
+func (scheduler *PauseResumeScheduler) RunSince(ctx context.Context, last time.Time, now time.Time) (records []*PauseResumeAuditRecord) {
+	for minute := last.Truncate(time.Minute).Add(time.Minute); !minute.After(now); minute = minute.Add(time.Minute) {
+		if ctx.Err() != nil {
+			break
+		}
+		records = append(records, scheduler.RunOnce(ctx, minute))
+	}
+	return
+}
+
+// RunOnce pauses and resumes the resources whose schedules select the minute of now, writes the audit record to
+// the audit log and returns it. A policy whose pause and resume schedules both select the minute is skipped.
+func (scheduler *PauseResumeScheduler) RunOnce(ctx context.Context, now time.Time) *PauseResumeAuditRecord {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	opts := scheduler.options
+	if opts.Location != nil {
+		now = now.In(opts.Location)
+	}
+	record := &PauseResumeAuditRecord{
+		RunAt:   now.Truncate(time.Minute),
+		Actions: []PauseResumeAction{},
+	}
+	for i, policy := range scheduler.policies {
+		schedules := scheduler.schedules[i]
+		pause := schedules[0] != nil && schedules[0].Matches(record.RunAt)
+		resume := schedules[1] != nil && schedules[1].Matches(record.RunAt)
+		if !pause && !resume {
+			continue
+		}
+		action := PauseResumeAction{
+			ResourceType: *policy.ResourceType,
+			ResourceID:   *policy.ResourceID,
+			Action:       PauseResumeAction_Action_Pause,
+		}
+		if resume {
+			action.Action = PauseResumeAction_Action_Resume
+		}
+		if pause && resume {
+			action.Outcome = PauseResumeAction_Outcome_Skipped
+			action.Reason = "pause and resume schedules both match"
+		} else {
+			scheduler.apply(ctx, &action)
+		}
+		record.Actions = append(record.Actions, action)
+	}
+	if opts.AuditLog != nil {
+		if line, err := json.Marshal(record); err == nil {
+			fmt.Fprintf(opts.AuditLog, "%s\n", line)
+		}
+	}
+	return record
+}
+
+// apply looks up the resource and makes the pause or resume call, recording the outcome in action.
+func (scheduler *PauseResumeScheduler) apply(ctx context.Context, action *PauseResumeAction) {
+	status, tags, err := scheduler.lookup(ctx, action.ResourceType, action.ResourceID)
+	if err != nil {
+		action.Outcome = PauseResumeAction_Outcome_Failed
+		action.Reason = err.Error()
+		return
+	}
+	opts := scheduler.options
+	if opts.ExclusionTag != nil {
+		for _, tag := range tags {
+			if tag == *opts.ExclusionTag {
+				action.Outcome = PauseResumeAction_Outcome_Skipped
+				action.Reason = "excluded by tag " + tag
+				return
+			}
+		}
+	}
+	switch {
+	case action.Action == PauseResumeAction_Action_Pause && (status == "stopped" || status == "paused"):
+		action.Outcome = PauseResumeAction_Outcome_Skipped
+		action.Reason = "already " + status
+		return
+	case action.Action == PauseResumeAction_Action_Resume && status == "running":
+		action.Outcome = PauseResumeAction_Outcome_Skipped
+		action.Reason = "already running"
+		return
+	}
+
+	if err = scheduler.call(ctx, action.ResourceType, action.ResourceID, action.Action == PauseResumeAction_Action_Pause); err != nil {
+		action.Outcome = PauseResumeAction_Outcome_Failed
+		action.Reason = err.Error()
+		return
+	}
+	action.Outcome = PauseResumeAction_Outcome_Done
+}
+
+// lookup returns the status and tags of the resource.
+func (scheduler *PauseResumeScheduler) lookup(ctx context.Context, resourceType string, id string) (status string, tags []string, err error) {
+	watsonxData, opts := scheduler.service, scheduler.options
+	switch resourceType {
+	case PauseResumePolicy_ResourceType_Presto:
+		var engine *PrestoEngine
+		engine, _, err = watsonxData.GetPrestoEngineWithContext(ctx, &GetPrestoEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+		if err == nil {
+			status, tags = core.StringNilMapper(engine.Status), engine.Tags
+		}
+	case PauseResumePolicy_ResourceType_Prestissimo:
+		var engine *PrestissimoEngine
+		engine, _, err = watsonxData.GetPrestissimoEngineWithContext(ctx, &GetPrestissimoEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+		if err == nil {
+			status, tags = core.StringNilMapper(engine.Status), engine.Tags
+		}
+	case PauseResumePolicy_ResourceType_Spark:
+		var engine *SparkEngine
+		engine, _, err = watsonxData.GetSparkEngineWithContext(ctx, &GetSparkEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+		if err == nil {
+			status, tags = core.StringNilMapper(engine.Status), engine.Tags
+		}
+	case PauseResumePolicy_ResourceType_Milvus:
+		var service *MilvusService
+		service, _, err = watsonxData.GetMilvusServiceWithContext(ctx, &GetMilvusServiceOptions{ServiceID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+		if err == nil {
+			status, tags = core.StringNilMapper(service.Status), service.Tags
+		}
+	}
+	return
+}
+
+// call makes the pause or resume call for the resource.
+func (scheduler *PauseResumeScheduler) call(ctx context.Context, resourceType string, id string, pause bool) (err error) {
+	watsonxData, opts := scheduler.service, scheduler.options
+	switch {
+	case resourceType == PauseResumePolicy_ResourceType_Presto && pause:
+		_, _, err = watsonxData.PausePrestoEngineWithContext(ctx, &PausePrestoEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	case resourceType == PauseResumePolicy_ResourceType_Presto:
+		_, _, err = watsonxData.ResumePrestoEngineWithContext(ctx, &ResumePrestoEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	case resourceType == PauseResumePolicy_ResourceType_Prestissimo && pause:
+		_, _, err = watsonxData.PausePrestissimoEngineWithContext(ctx, &PausePrestissimoEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	case resourceType == PauseResumePolicy_ResourceType_Prestissimo:
+		_, _, err = watsonxData.ResumePrestissimoEngineWithContext(ctx, &ResumePrestissimoEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	case resourceType == PauseResumePolicy_ResourceType_Spark && pause:
+		_, _, err = watsonxData.PauseSparkEngineWithContext(ctx, &PauseSparkEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	case resourceType == PauseResumePolicy_ResourceType_Spark:
+		_, _, err = watsonxData.ResumeSparkEngineWithContext(ctx, &ResumeSparkEngineOptions{EngineID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	case resourceType == PauseResumePolicy_ResourceType_Milvus && pause:
+		_, _, err = watsonxData.CreateMilvusServicePauseWithContext(ctx, &CreateMilvusServicePauseOptions{ServiceID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	case resourceType == PauseResumePolicy_ResourceType_Milvus:
+		_, _, err = watsonxData.CreateMilvusServiceResumeWithContext(ctx, &CreateMilvusServiceResumeOptions{ServiceID: &id, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	}
+	return
+}
+
+// PauseResumeSchedulerOptions : The NewPauseResumeScheduler options.
+type PauseResumeSchedulerOptions struct {
+	// Pause and resume policies, at most one per resource.
+	Policies []PauseResumePolicy `json:"policies" validate:"required,min=1,dive"`
+
+	// Resources with this tag are never paused or resumed.
+	ExclusionTag *string `json:"exclusion_tag,omitempty"`
+
+	// Time zone the cron expressions are evaluated in. Defaults to the zone of the time passed to RunOnce, which is
+	// local time for Run.
+	Location *time.Location
+
+	// If set, each audit record is written to AuditLog as a line of JSON.
+	AuditLog io.Writer
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPauseResumeSchedulerOptions : Instantiate PauseResumeSchedulerOptions
+func (*WatsonxDataV2) NewPauseResumeSchedulerOptions(policies []PauseResumePolicy) *PauseResumeSchedulerOptions {
+	return &PauseResumeSchedulerOptions{
+		Policies: policies,
+	}
+}
+
+// SetPolicies : Allow user to set Policies
+func (_options *PauseResumeSchedulerOptions) SetPolicies(policies []PauseResumePolicy) *PauseResumeSchedulerOptions {
+	_options.Policies = policies
+	return _options
+}
+
+// SetExclusionTag : Allow user to set ExclusionTag
+func (_options *PauseResumeSchedulerOptions) SetExclusionTag(exclusionTag string) *PauseResumeSchedulerOptions {
+	_options.ExclusionTag = core.StringPtr(exclusionTag)
+	return _options
+}
+
+// SetLocation : Allow user to set Location
+func (_options *PauseResumeSchedulerOptions) SetLocation(location *time.Location) *PauseResumeSchedulerOptions {
+	_options.Location = location
+	return _options
+}
+
+// SetAuditLog : Allow user to set AuditLog
+func (_options *PauseResumeSchedulerOptions) SetAuditLog(auditLog io.Writer) *PauseResumeSchedulerOptions {
+	_options.AuditLog = auditLog
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *PauseResumeSchedulerOptions) SetAuthInstanceID(authInstanceID string) *PauseResumeSchedulerOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PauseResumeSchedulerOptions) SetHeaders(param map[string]string) *PauseResumeSchedulerOptions {
+	options.Headers = param
+	return options
+}
@@ -0,0 +1,232 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 pause/resume scheduler`, func() {
+	Describe(`CronSchedule`, func() {
+		It(`Match and find the next time`, func() {
+			schedule, err := watsonxdatav2.ParseCronSchedule("30 20 * * 1-5")
+			Expect(err).To(BeNil())
+			friday := time.Date(2025, 6, 6, 20, 30, 0, 0, time.UTC)
+			Expect(schedule.Matches(friday)).To(BeTrue())
+			Expect(schedule.Matches(friday.Add(time.Minute))).To(BeFalse())
+			Expect(schedule.Next(friday)).To(Equal(time.Date(2025, 6, 9, 20, 30, 0, 0, time.UTC)))
+
+			schedule, err = watsonxdatav2.ParseCronSchedule("*/15 8-18/2 1,15 * 7")
+			Expect(err).To(BeNil())
+			Expect(schedule.Matches(time.Date(2025, 6, 1, 10, 45, 0, 0, time.UTC))).To(BeTrue())  // the 1st, a Sunday
+			Expect(schedule.Matches(time.Date(2025, 6, 8, 10, 45, 0, 0, time.UTC))).To(BeTrue())  // a Sunday
+			Expect(schedule.Matches(time.Date(2025, 6, 15, 10, 45, 0, 0, time.UTC))).To(BeTrue()) // the 15th
+			Expect(schedule.Matches(time.Date(2025, 6, 16, 10, 45, 0, 0, time.UTC))).To(BeFalse())
+			Expect(schedule.Matches(time.Date(2025, 6, 8, 11, 45, 0, 0, time.UTC))).To(BeFalse())
+			Expect(schedule.String()).To(Equal("*/15 8-18/2 1,15 * 7"))
+
+			for _, spec := range []string{"* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "a * * * *", "5-1 * * * *"} {
+				_, err = watsonxdatav2.ParseCronSchedule(spec)
+				Expect(err).ToNot(BeNil(), spec)
+			}
+		})
+	})
+	Describe(`PauseResumeScheduler`, func() {
+		var testServer *httptest.Server
+		var calls []string
+		BeforeEach(func() {
+			calls = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == "POST" {
+					calls = append(calls, req.URL.EscapedPath())
+					res.WriteHeader(201)
+					fmt.Fprint(res, `{}`)
+					return
+				}
+				switch req.URL.EscapedPath() {
+				case "/presto_engines/presto01":
+					fmt.Fprint(res, `{"engine_id": "presto01", "external_host_name": "h", "status_code": 200, "status": "running"}`)
+				case "/prestissimo_engines/prestissimo01":
+					fmt.Fprint(res, `{"engine_id": "prestissimo01", "external_host_name": "h", "status_code": 200, "status": "running", "tags": ["always-on"]}`)
+				case "/spark_engines/spark01":
+					fmt.Fprint(res, `{"engine_id": "spark01", "status": "stopped"}`)
+				case "/milvus_services/milvus01":
+					fmt.Fprint(res, `{"service_id": "milvus01", "status": "stopped"}`)
+				default:
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}]}`)
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		policies := func() []watsonxdatav2.PauseResumePolicy {
+			policy := func(resourceType string, id string) watsonxdatav2.PauseResumePolicy {
+				return watsonxdatav2.PauseResumePolicy{
+					ResourceType:   core.StringPtr(resourceType),
+					ResourceID:     core.StringPtr(id),
+					PauseSchedule:  core.StringPtr("0 20 * * *"),
+					ResumeSchedule: core.StringPtr("0 7 * * 1-5"),
+				}
+			}
+			return []watsonxdatav2.PauseResumePolicy{
+				policy("presto", "presto01"),
+				policy("prestissimo", "prestissimo01"),
+				policy("spark", "spark01"),
+				policy("milvus", "milvus01"),
+				policy("presto", "missing"),
+			}
+		}
+		It(`Pause and resume on schedule and write audit records`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			scheduler, err := watsonxDataService.NewPauseResumeScheduler(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(scheduler).To(BeNil())
+
+			auditLog := new(bytes.Buffer)
+			pauseResumeSchedulerOptionsModel := watsonxDataService.NewPauseResumeSchedulerOptions(policies())
+			pauseResumeSchedulerOptionsModel.SetExclusionTag("always-on")
+			pauseResumeSchedulerOptionsModel.SetAuditLog(auditLog)
+			pauseResumeSchedulerOptionsModel.SetLocation(time.UTC)
+			scheduler, err = watsonxDataService.NewPauseResumeScheduler(pauseResumeSchedulerOptionsModel)
+			Expect(err).To(BeNil())
+
+			record := scheduler.RunOnce(context.Background(), time.Date(2025, 6, 6, 20, 0, 42, 0, time.UTC))
+			Expect(record.RunAt).To(Equal(time.Date(2025, 6, 6, 20, 0, 0, 0, time.UTC)))
+			Expect(calls).To(Equal([]string{"/presto_engines/presto01/pause"}))
+			Expect(record.Actions).To(HaveLen(5))
+			Expect(record.Actions[0].Outcome).To(Equal(watsonxdatav2.PauseResumeAction_Outcome_Done))
+			Expect(record.Actions[1].Outcome).To(Equal(watsonxdatav2.PauseResumeAction_Outcome_Skipped))
+			Expect(record.Actions[1].Reason).To(Equal("excluded by tag always-on"))
+			Expect(record.Actions[2].Reason).To(Equal("already stopped"))
+			Expect(record.Actions[4].Outcome).To(Equal(watsonxdatav2.PauseResumeAction_Outcome_Failed))
+
+			calls = nil
+			record = scheduler.RunOnce(context.Background(), time.Date(2025, 6, 9, 7, 0, 0, 0, time.UTC))
+			Expect(calls).To(Equal([]string{"/spark_engines/spark01/resume", "/milvus_services/milvus01/resume"}))
+			Expect(record.Actions[0].Action).To(Equal(watsonxdatav2.PauseResumeAction_Action_Resume))
+			Expect(record.Actions[0].Reason).To(Equal("already running"))
+
+			calls = nil
+			record = scheduler.RunOnce(context.Background(), time.Date(2025, 6, 7, 7, 0, 0, 0, time.UTC))
+			Expect(calls).To(BeEmpty())
+			Expect(record.Actions).To(BeEmpty())
+
+			var audited []watsonxdatav2.PauseResumeAuditRecord
+			decoder := json.NewDecoder(auditLog)
+			for decoder.More() {
+				var line watsonxdatav2.PauseResumeAuditRecord
+				Expect(decoder.Decode(&line)).To(Succeed())
+				audited = append(audited, line)
+			}
+			Expect(audited).To(HaveLen(3))
+			Expect(audited[0].Actions[0]).To(Equal(watsonxdatav2.PauseResumeAction{
+				ResourceType: "presto",
+				ResourceID:   "presto01",
+				Action:       "pause",
+				Outcome:      "done",
+			}))
+		})
+		It(`Evaluate every minute since the last run`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			pauseResumeSchedulerOptionsModel := watsonxDataService.NewPauseResumeSchedulerOptions(policies())
+			pauseResumeSchedulerOptionsModel.SetExclusionTag("always-on")
+			pauseResumeSchedulerOptionsModel.SetLocation(time.UTC)
+			scheduler, err := watsonxDataService.NewPauseResumeScheduler(pauseResumeSchedulerOptionsModel)
+			Expect(err).To(BeNil())
+
+			// A run at 19:58 that ended after 20:01 still evaluates 20:00
+			records := scheduler.RunSince(context.Background(), time.Date(2025, 6, 6, 19, 58, 30, 0, time.UTC), time.Date(2025, 6, 6, 20, 1, 10, 0, time.UTC))
+			Expect(records).To(HaveLen(3))
+			Expect(records[0].RunAt).To(Equal(time.Date(2025, 6, 6, 19, 59, 0, 0, time.UTC)))
+			Expect(records[1].RunAt).To(Equal(time.Date(2025, 6, 6, 20, 0, 0, 0, time.UTC)))
+			Expect(records[2].RunAt).To(Equal(time.Date(2025, 6, 6, 20, 1, 0, 0, time.UTC)))
+			Expect(records[0].Actions).To(BeEmpty())
+			Expect(records[1].Actions).To(HaveLen(5))
+			Expect(records[2].Actions).To(BeEmpty())
+			Expect(calls).To(Equal([]string{"/presto_engines/presto01/pause"}))
+
+			// Nothing is evaluated twice within a minute
+			Expect(scheduler.RunSince(context.Background(), time.Date(2025, 6, 6, 20, 1, 0, 0, time.UTC), time.Date(2025, 6, 6, 20, 1, 59, 0, time.UTC))).To(BeEmpty())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			Expect(scheduler.RunSince(ctx, time.Date(2025, 6, 6, 19, 0, 0, 0, time.UTC), time.Date(2025, 6, 6, 20, 0, 0, 0, time.UTC))).To(BeEmpty())
+		})
+		It(`Reject invalid policies`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			policy, err := watsonxDataService.NewPauseResumePolicy("netezza", "netezza01")
+			Expect(err).To(BeNil())
+			policy.PauseSchedule = core.StringPtr("0 20 * * *")
+			_, err = watsonxDataService.NewPauseResumeScheduler(watsonxDataService.NewPauseResumeSchedulerOptions([]watsonxdatav2.PauseResumePolicy{*policy}))
+			Expect(err).ToNot(BeNil())
+
+			policy.ResourceType = core.StringPtr("spark")
+			policy.PauseSchedule = nil
+			_, err = watsonxDataService.NewPauseResumeScheduler(watsonxDataService.NewPauseResumeSchedulerOptions([]watsonxdatav2.PauseResumePolicy{*policy}))
+			Expect(err).ToNot(BeNil())
+
+			policy.PauseSchedule = core.StringPtr("at eight")
+			_, err = watsonxDataService.NewPauseResumeScheduler(watsonxDataService.NewPauseResumeSchedulerOptions([]watsonxdatav2.PauseResumePolicy{*policy}))
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Stop running when the context is done`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			scheduler, err := watsonxDataService.NewPauseResumeScheduler(watsonxDataService.NewPauseResumeSchedulerOptions(policies()))
+			Expect(err).To(BeNil())
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			Expect(scheduler.Run(ctx)).To(Equal(context.DeadlineExceeded))
+		})
+	})
+})
@@ -0,0 +1,540 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// PrestissimoProperty : Definition of a known Prestissimo (Velox) property.
+type PrestissimoProperty struct {
+	// Property name, as used on the left-hand side of a "name=value" entry.
+	Name string
+
+	// Where the property is set: in the velox or the catalog engine properties.
+	Scope string
+
+	// Value type.
+	Type string
+
+	// Inclusive lower bound for integer and double properties.
+	Min *float64
+
+	// Inclusive upper bound for integer and double properties.
+	Max *float64
+
+	// Allowed values for string properties. Any value is allowed if empty.
+	Allowed []string
+
+	// Value Prestissimo uses when the property is not set.
+	Default string
+
+	// Short description.
+	Description string
+}
+
+// Constants associated with the PrestissimoProperty.Scope property.
+// Where the property is set: in the velox or the catalog engine properties.
+const (
+	PrestissimoProperty_Scope_Catalog = "catalog"
+	PrestissimoProperty_Scope_Velox   = "velox"
+)
+
+// Constants associated with the PrestissimoProperty.Type property.
+// Value type.
+const (
+	PrestissimoProperty_Type_Boolean  = "boolean"
+	PrestissimoProperty_Type_DataSize = "data_size"
+	PrestissimoProperty_Type_Double   = "double"
+	PrestissimoProperty_Type_Duration = "duration"
+	PrestissimoProperty_Type_Integer  = "integer"
+	PrestissimoProperty_Type_String   = "string"
+)
+
+var (
+	prestissimoDataSizePattern = regexp.MustCompile(`^\d+(\.\d+)?\s*(B|kB|KB|MB|GB|TB|PB)$`)
+	prestissimoDurationPattern = regexp.MustCompile(`^\d+(\.\d+)?\s*(ns|us|ms|s|m|h|d)$`)
+)
+
+// Validate returns an error if value is not a valid value of the property.
+func (property *PrestissimoProperty) Validate(value string) error {
+	switch property.Type {
+	case PrestissimoProperty_Type_Boolean:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("%s must be true or false, got '%s'", property.Name, value)
+		}
+		return nil
+	case PrestissimoProperty_Type_DataSize:
+		if !prestissimoDataSizePattern.MatchString(value) {
+			return fmt.Errorf("%s must be a data size such as 512MB, got '%s'", property.Name, value)
+		}
+		return nil
+	case PrestissimoProperty_Type_Duration:
+		if !prestissimoDurationPattern.MatchString(value) {
+			return fmt.Errorf("%s must be a duration such as 30s, got '%s'", property.Name, value)
+		}
+		return nil
+	case PrestissimoProperty_Type_String:
+		if len(property.Allowed) == 0 {
+			return nil
+		}
+		for _, allowed := range property.Allowed {
+			if value == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s must be one of %s, got '%s'", property.Name, strings.Join(property.Allowed, ", "), value)
+	}
+
+	var number float64
+	var err error
+	if property.Type == PrestissimoProperty_Type_Integer {
+		var integer int64
+		integer, err = strconv.ParseInt(value, 10, 64)
+		number = float64(integer)
+	} else {
+		number, err = strconv.ParseFloat(value, 64)
+	}
+	if err != nil {
+		return fmt.Errorf("%s must be of type %s, got '%s'", property.Name, property.Type, value)
+	}
+	if property.Min != nil && number < *property.Min {
+		return fmt.Errorf("%s must be at least %v, got %s", property.Name, *property.Min, value)
+	}
+	if property.Max != nil && number > *property.Max {
+		return fmt.Errorf("%s must be at most %v, got %s", property.Name, *property.Max, value)
+	}
+	return nil
+}
+
+// prestissimoProperties is the catalog of known properties, keyed by name.
+var prestissimoProperties = map[string]*PrestissimoProperty{}
+
+func init() {
+	for _, property := range []PrestissimoProperty{
+		{Name: "async-data-cache-enabled", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Boolean, Default: "true", Description: "Cache data read from remote storage in memory and on local SSD."},
+		{Name: "driver.num-cpu-threads-hw-multiplier", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Double, Min: core.Float64Ptr(0.1), Max: core.Float64Ptr(16), Default: "4.0", Description: "Number of driver threads per hardware thread."},
+		{Name: "exchange.max-buffer-size", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_DataSize, Default: "32MB", Description: "Size of the buffer for data received from other workers."},
+		{Name: "memory-arbitrator-kind", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_String, Allowed: []string{"", "SHARED"}, Default: "", Description: "Memory arbitrator moving memory between queries. Empty disables arbitration."},
+		{Name: "num-io-threads", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Integer, Min: core.Float64Ptr(1), Max: core.Float64Ptr(1024), Default: "30", Description: "Number of threads for reads from remote storage."},
+		{Name: "query-memory-gb", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Integer, Min: core.Float64Ptr(1), Default: "38", Description: "Memory available to queries on a worker, in GB. Must not exceed system-memory-gb."},
+		{Name: "query.max-memory-per-node", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_DataSize, Default: "4GB", Description: "Maximum memory a single query can use on a worker."},
+		{Name: "shutdown-onset-sec", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Integer, Min: core.Float64Ptr(0), Default: "10", Description: "Seconds a worker waits for running tasks before it shuts down."},
+		{Name: "system-mem-limit-gb", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Integer, Min: core.Float64Ptr(1), Default: "55", Description: "Process memory above which memory pushback starts, in GB."},
+		{Name: "system-mem-pushback-enabled", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Boolean, Default: "false", Description: "Shrink caches and spill when process memory passes system-mem-limit-gb."},
+		{Name: "system-mem-shrink-gb", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Integer, Min: core.Float64Ptr(1), Default: "8", Description: "Memory to free on each pushback, in GB."},
+		{Name: "system-memory-gb", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Integer, Min: core.Float64Ptr(1), Default: "57", Description: "Memory available to the worker process, in GB."},
+		{Name: "task.max-drivers-per-task", Scope: PrestissimoProperty_Scope_Velox, Type: PrestissimoProperty_Type_Integer, Min: core.Float64Ptr(1), Max: core.Float64Ptr(1024), Default: "16", Description: "Maximum number of drivers running a single task."},
+		{Name: "file-column-names-read-as-lower-case", Scope: PrestissimoProperty_Scope_Catalog, Type: PrestissimoProperty_Type_Boolean, Default: "false", Description: "Match file column names case-insensitively."},
+		{Name: "hive.orc.use-column-names", Scope: PrestissimoProperty_Scope_Catalog, Type: PrestissimoProperty_Type_Boolean, Default: "false", Description: "Map ORC columns by name instead of by position."},
+		{Name: "hive.parquet.use-column-names", Scope: PrestissimoProperty_Scope_Catalog, Type: PrestissimoProperty_Type_Boolean, Default: "false", Description: "Map Parquet columns by name instead of by position."},
+		{Name: "max-coalesced-bytes", Scope: PrestissimoProperty_Scope_Catalog, Type: PrestissimoProperty_Type_DataSize, Default: "128MB", Description: "Maximum size of a single coalesced read from storage."},
+		{Name: "max-partitions-per-writers", Scope: PrestissimoProperty_Scope_Catalog, Type: PrestissimoProperty_Type_Integer, Min: core.Float64Ptr(1), Default: "100", Description: "Maximum number of partitions a single writer can write to."},
+	} {
+		prestissimoProperties[property.Name] = &property
+	}
+}
+
+// PrestissimoProperties : Return the known Prestissimo properties, ordered by scope and name.
+func PrestissimoProperties() []PrestissimoProperty {
+	properties := make([]PrestissimoProperty, 0, len(prestissimoProperties))
+	for _, property := range prestissimoProperties {
+		properties = append(properties, *property)
+	}
+	sort.Slice(properties, func(i, j int) bool {
+		if properties[i].Scope != properties[j].Scope {
+			return properties[i].Scope > properties[j].Scope
+		}
+		return properties[i].Name < properties[j].Name
+	})
+	return properties
+}
+
+// LookupPrestissimoProperty : Return the definition of a known Prestissimo property.
+func LookupPrestissimoProperty(name string) (property PrestissimoProperty, ok bool) {
+	if definition, found := prestissimoProperties[name]; found {
+		return *definition, true
+	}
+	return
+}
+
+// ValidatePrestissimoEnginePatch : Check the property values of a PrestissimoEnginePatch
+// Every velox property must be a "name=value" entry, and the values of known velox properties must be valid. Catalog
+// entries that contain "=" are checked the same way against the known catalog properties; other catalog entries are
+// catalog names and are not checked. Properties that are not known here are accepted as they are; list them with
+// UnknownPrestissimoProperties to warn about them. query-memory-gb must not exceed system-memory-gb when both are
+// set; ValidateAndUpdatePrestissimoEngine also checks a patch that sets only one of them against the engine. All
+// problems are reported in a single error.
+func ValidatePrestissimoEnginePatch(patch *PrestissimoEnginePatch) error {
+	return validatePrestissimoEnginePatch(patch, nil)
+}
+
+// validatePrestissimoEnginePatch checks the patch like ValidatePrestissimoEnginePatch. If current is not nil, it
+// holds the engine's velox properties, and a memory property missing from the patch is taken from it, or from the
+// property default if the engine does not set it.
+func validatePrestissimoEnginePatch(patch *PrestissimoEnginePatch, current map[string]string) error {
+	if patch == nil || patch.EngineProperties == nil {
+		return nil
+	}
+	var problems []string
+	check := func(entry string, scope string) (name string, value string) {
+		separator := strings.Index(entry, "=")
+		if separator < 0 {
+			problems = append(problems, fmt.Sprintf("%s property '%s' must be of the form name=value", scope, entry))
+			return
+		}
+		name, value = strings.TrimSpace(entry[:separator]), strings.TrimSpace(entry[separator+1:])
+		property, ok := prestissimoProperties[name]
+		if !ok || property.Scope != scope {
+			return "", ""
+		}
+		if err := property.Validate(value); err != nil {
+			problems = append(problems, err.Error())
+			return "", ""
+		}
+		return
+	}
+
+	velox := map[string]string{}
+	patched := map[string]bool{}
+	if patch.EngineProperties.Velox != nil {
+		for _, entry := range patch.EngineProperties.Velox.VeloxProperty {
+			patched[strings.TrimSpace(strings.SplitN(entry, "=", 2)[0])] = true
+			if name, value := check(entry, PrestissimoProperty_Scope_Velox); name != "" {
+				velox[name] = value
+			}
+		}
+	}
+	if patch.EngineProperties.Catalog != nil {
+		for _, entry := range patch.EngineProperties.Catalog.CatalogName {
+			if strings.Contains(entry, "=") {
+				check(entry, PrestissimoProperty_Scope_Catalog)
+			}
+		}
+	}
+	if current != nil {
+		for _, name := range []string{"query-memory-gb", "system-memory-gb"} {
+			if !patched[name] {
+				velox[name] = current[name]
+				if velox[name] == "" {
+					velox[name] = prestissimoProperties[name].Default
+				}
+			}
+		}
+	}
+	if query, system := velox["query-memory-gb"], velox["system-memory-gb"]; query != "" && system != "" {
+		queryGB, queryErr := strconv.Atoi(query)
+		systemGB, systemErr := strconv.Atoi(system)
+		if queryErr == nil && systemErr == nil && queryGB > systemGB {
+			problems = append(problems, fmt.Sprintf("query-memory-gb (%d) must not exceed system-memory-gb (%d)", queryGB, systemGB))
+		}
+	}
+
+	if len(problems) > 0 {
+		return core.SDKErrorf(nil, "invalid Prestissimo engine properties: "+strings.Join(problems, "; "), "invalid-engine-property", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// UnknownPrestissimoProperties : Return the property names of a PrestissimoEnginePatch that are not known
+// Names are prefixed with their scope, as in "velox.name", and returned in patch order. The properties are valid for
+// ValidatePrestissimoEnginePatch, which does not check their values; the list is meant for warnings about typos.
+func UnknownPrestissimoProperties(patch *PrestissimoEnginePatch) (names []string) {
+	if patch == nil || patch.EngineProperties == nil {
+		return
+	}
+	check := func(entry string, scope string) {
+		name, _, ok := strings.Cut(entry, "=")
+		if !ok {
+			return
+		}
+		name = strings.TrimSpace(name)
+		if property, known := prestissimoProperties[name]; !known || property.Scope != scope {
+			names = append(names, scope+"."+name)
+		}
+	}
+	if patch.EngineProperties.Velox != nil {
+		for _, entry := range patch.EngineProperties.Velox.VeloxProperty {
+			check(entry, PrestissimoProperty_Scope_Velox)
+		}
+	}
+	if patch.EngineProperties.Catalog != nil {
+		for _, entry := range patch.EngineProperties.Catalog.CatalogName {
+			check(entry, PrestissimoProperty_Scope_Catalog)
+		}
+	}
+	return
+}
+
+// PrestissimoTuningProfile : A named set of velox properties for a kind of workload.
+type PrestissimoTuningProfile struct {
+	// Profile name.
+	Name string
+
+	// What the profile is for.
+	Description string
+
+	// Velox property values, keyed by property name.
+	Properties map[string]string
+}
+
+// Constants for the names of the built-in tuning profiles.
+const (
+	PrestissimoTuningProfile_HighConcurrency = "high-concurrency"
+	PrestissimoTuningProfile_IoHeavy         = "io-heavy"
+	PrestissimoTuningProfile_MemoryHeavy     = "memory-heavy"
+)
+
+// prestissimoTuningProfiles are the built-in tuning profiles, keyed by name.
+var prestissimoTuningProfiles = map[string]PrestissimoTuningProfile{
+	PrestissimoTuningProfile_HighConcurrency: {
+		Name:        PrestissimoTuningProfile_HighConcurrency,
+		Description: "Many small queries at once: fewer drivers and less memory per query, larger exchange buffers.",
+		Properties: map[string]string{
+			"driver.num-cpu-threads-hw-multiplier": "2.0",
+			"exchange.max-buffer-size":             "64MB",
+			"query.max-memory-per-node":            "2GB",
+			"task.max-drivers-per-task":            "4",
+		},
+	},
+	PrestissimoTuningProfile_IoHeavy: {
+		Name:        PrestissimoTuningProfile_IoHeavy,
+		Description: "Scan-bound queries over remote storage: data cache on and more I/O threads.",
+		Properties: map[string]string{
+			"async-data-cache-enabled": "true",
+			"num-io-threads":           "64",
+		},
+	},
+	PrestissimoTuningProfile_MemoryHeavy: {
+		Name:        PrestissimoTuningProfile_MemoryHeavy,
+		Description: "Large joins and aggregations: more memory per query, shared arbitration and memory pushback.",
+		Properties: map[string]string{
+			"memory-arbitrator-kind":      "SHARED",
+			"query.max-memory-per-node":   "16GB",
+			"system-mem-pushback-enabled": "true",
+			"task.max-drivers-per-task":   "8",
+		},
+	},
+}
+
+// PrestissimoTuningProfiles : Return the built-in tuning profiles, ordered by name.
+func PrestissimoTuningProfiles() []PrestissimoTuningProfile {
+	profiles := make([]PrestissimoTuningProfile, 0, len(prestissimoTuningProfiles))
+	for _, profile := range prestissimoTuningProfiles {
+		profiles = append(profiles, profile)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	return profiles
+}
+
+// ExpandPrestissimoTuningProfile : Return the velox properties of a tuning profile as sorted "name=value" entries.
+func ExpandPrestissimoTuningProfile(name string) (veloxProperties []string, err error) {
+	profile, ok := prestissimoTuningProfiles[name]
+	if !ok {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unknown tuning profile '%s'", name), "unknown-tuning-profile", common.GetComponentInfo())
+		return
+	}
+	for property, value := range profile.Properties {
+		veloxProperties = append(veloxProperties, property+"="+value)
+	}
+	sort.Strings(veloxProperties)
+	return
+}
+
+// applyPrestissimoTuningProfile adds the velox properties of the profile to the patch. Properties already set in
+// the patch are kept.
+func applyPrestissimoTuningProfile(patch *PrestissimoEnginePatch, name string) error {
+	entries, err := ExpandPrestissimoTuningProfile(name)
+	if err != nil {
+		return err
+	}
+	if patch.EngineProperties == nil {
+		patch.EngineProperties = new(PrestissimoEngineEngineProperties)
+	}
+	if patch.EngineProperties.Velox == nil {
+		patch.EngineProperties.Velox = new(PrestissimoEnginePropertiesVelox)
+	}
+	velox := patch.EngineProperties.Velox
+	set := map[string]bool{}
+	for _, entry := range velox.VeloxProperty {
+		set[strings.TrimSpace(strings.SplitN(entry, "=", 2)[0])] = true
+	}
+	for _, entry := range entries {
+		if !set[strings.SplitN(entry, "=", 2)[0]] {
+			velox.VeloxProperty = append(velox.VeloxProperty, entry)
+		}
+	}
+	return nil
+}
+
+// ValidateAndUpdatePrestissimoEngine : Validate and send a Prestissimo engine update
+// Expand the tuning profile, if one is given, into the velox properties of the patch, validate the patch with
+// ValidatePrestissimoEnginePatch and send it with UpdatePrestissimoEngine. If the patch sets only one of
+// query-memory-gb and system-memory-gb, the engine is read to check it against the other. Nothing is sent if the
+// patch is invalid.
+func (watsonxData *WatsonxDataV2) ValidateAndUpdatePrestissimoEngine(validateAndUpdatePrestissimoEngineOptions *ValidateAndUpdatePrestissimoEngineOptions) (result *PrestissimoEngine, response *core.DetailedResponse, err error) {
+	result, response, err = watsonxData.ValidateAndUpdatePrestissimoEngineWithContext(context.Background(), validateAndUpdatePrestissimoEngineOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ValidateAndUpdatePrestissimoEngineWithContext is an alternate form of the ValidateAndUpdatePrestissimoEngine method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) ValidateAndUpdatePrestissimoEngineWithContext(ctx context.Context, validateAndUpdatePrestissimoEngineOptions *ValidateAndUpdatePrestissimoEngineOptions) (result *PrestissimoEngine, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(validateAndUpdatePrestissimoEngineOptions, "validateAndUpdatePrestissimoEngineOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(validateAndUpdatePrestissimoEngineOptions, "validateAndUpdatePrestissimoEngineOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := validateAndUpdatePrestissimoEngineOptions
+
+	patch := new(PrestissimoEnginePatch)
+	if opts.Patch != nil {
+		*patch = *opts.Patch
+		if patch.EngineProperties != nil {
+			engineProperties := *patch.EngineProperties
+			if engineProperties.Velox != nil {
+				engineProperties.Velox = &PrestissimoEnginePropertiesVelox{
+					VeloxProperty: append([]string(nil), engineProperties.Velox.VeloxProperty...),
+				}
+			}
+			patch.EngineProperties = &engineProperties
+		}
+	}
+	if opts.Profile != nil {
+		if err = applyPrestissimoTuningProfile(patch, *opts.Profile); err != nil {
+			return
+		}
+	}
+	// A memory property patched on its own is checked against the engine's value of the other one
+	var current map[string]string
+	if velox := veloxPropertyValues(patch); (velox["query-memory-gb"] == "") != (velox["system-memory-gb"] == "") {
+		var engine *PrestissimoEngine
+		var getResponse *core.DetailedResponse
+		engine, getResponse, err = watsonxData.GetPrestissimoEngineWithContext(ctx, &GetPrestissimoEngineOptions{
+			EngineID:       opts.EngineID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+		if err != nil {
+			response = getResponse
+			err = core.RepurposeSDKProblem(err, "get-engine-error")
+			return
+		}
+		current = veloxPropertyValues(&PrestissimoEnginePatch{EngineProperties: engine.EngineProperties})
+	}
+	if err = validatePrestissimoEnginePatch(patch, current); err != nil {
+		return
+	}
+
+	body, err := patch.AsPatch()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "patch-error", common.GetComponentInfo())
+		return
+	}
+	result, response, err = watsonxData.UpdatePrestissimoEngineWithContext(ctx, &UpdatePrestissimoEngineOptions{
+		EngineID:       opts.EngineID,
+		Body:           body,
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	return
+}
+
+// ValidateAndUpdatePrestissimoEngineOptions : The ValidateAndUpdatePrestissimoEngine options.
+type ValidateAndUpdatePrestissimoEngineOptions struct {
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Update prestissimo engine body. Not modified by the call.
+	Patch *PrestissimoEnginePatch `json:"patch,omitempty"`
+
+	// Name of a tuning profile whose velox properties are added to the patch, for example "memory-heavy". Velox
+	// properties set in Patch take precedence over the profile.
+	Profile *string `json:"profile,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewValidateAndUpdatePrestissimoEngineOptions : Instantiate ValidateAndUpdatePrestissimoEngineOptions
+func (*WatsonxDataV2) NewValidateAndUpdatePrestissimoEngineOptions(engineID string) *ValidateAndUpdatePrestissimoEngineOptions {
+	return &ValidateAndUpdatePrestissimoEngineOptions{
+		EngineID: core.StringPtr(engineID),
+	}
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *ValidateAndUpdatePrestissimoEngineOptions) SetEngineID(engineID string) *ValidateAndUpdatePrestissimoEngineOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetPatch : Allow user to set Patch
+func (_options *ValidateAndUpdatePrestissimoEngineOptions) SetPatch(patch *PrestissimoEnginePatch) *ValidateAndUpdatePrestissimoEngineOptions {
+	_options.Patch = patch
+	return _options
+}
+
+// SetProfile : Allow user to set Profile
+func (_options *ValidateAndUpdatePrestissimoEngineOptions) SetProfile(profile string) *ValidateAndUpdatePrestissimoEngineOptions {
+	_options.Profile = core.StringPtr(profile)
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *ValidateAndUpdatePrestissimoEngineOptions) SetAuthInstanceID(authInstanceID string) *ValidateAndUpdatePrestissimoEngineOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ValidateAndUpdatePrestissimoEngineOptions) SetHeaders(param map[string]string) *ValidateAndUpdatePrestissimoEngineOptions {
+	options.Headers = param
+	return options
+}
+
+// veloxPropertyValues returns the "name=value" velox properties of the patch as a map.
+func veloxPropertyValues(patch *PrestissimoEnginePatch) map[string]string {
+	values := map[string]string{}
+	if patch.EngineProperties == nil || patch.EngineProperties.Velox == nil {
+		return values
+	}
+	for _, entry := range patch.EngineProperties.Velox.VeloxProperty {
+		if name, value, ok := strings.Cut(entry, "="); ok {
+			values[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+	return values
+}
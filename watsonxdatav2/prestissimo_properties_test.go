@@ -0,0 +1,237 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 Prestissimo properties`, func() {
+	Describe(`PrestissimoProperty`, func() {
+		It(`Validate values by type and range`, func() {
+			property, ok := watsonxdatav2.LookupPrestissimoProperty("task.max-drivers-per-task")
+			Expect(ok).To(BeTrue())
+			Expect(property.Default).To(Equal("16"))
+			Expect(property.Validate("8")).To(Succeed())
+			Expect(property.Validate("0")).ToNot(Succeed())
+			Expect(property.Validate("eight")).ToNot(Succeed())
+
+			property, _ = watsonxdatav2.LookupPrestissimoProperty("query.max-memory-per-node")
+			Expect(property.Validate("512MB")).To(Succeed())
+			Expect(property.Validate("lots")).ToNot(Succeed())
+
+			property, _ = watsonxdatav2.LookupPrestissimoProperty("memory-arbitrator-kind")
+			Expect(property.Validate("SHARED")).To(Succeed())
+			Expect(property.Validate("NOOP")).ToNot(Succeed())
+
+			_, ok = watsonxdatav2.LookupPrestissimoProperty("no-such-property")
+			Expect(ok).To(BeFalse())
+
+			properties := watsonxdatav2.PrestissimoProperties()
+			Expect(properties[0].Scope).To(Equal(watsonxdatav2.PrestissimoProperty_Scope_Velox))
+			Expect(properties[len(properties)-1].Scope).To(Equal(watsonxdatav2.PrestissimoProperty_Scope_Catalog))
+		})
+		It(`Validate a PrestissimoEnginePatch`, func() {
+			Expect(watsonxdatav2.ValidatePrestissimoEnginePatch(nil)).To(Succeed())
+
+			patch := &watsonxdatav2.PrestissimoEnginePatch{
+				EngineProperties: &watsonxdatav2.PrestissimoEngineEngineProperties{
+					Velox: &watsonxdatav2.PrestissimoEnginePropertiesVelox{
+						VeloxProperty: []string{"num-io-threads=48", "system-memory-gb=40"},
+					},
+					Catalog: &watsonxdatav2.PrestissimoEnginePropertiesCatalog{
+						CatalogName: []string{"iceberg_data", "hive.parquet.use-column-names=true"},
+					},
+				},
+			}
+			Expect(watsonxdatav2.ValidatePrestissimoEnginePatch(patch)).To(Succeed())
+			Expect(watsonxdatav2.UnknownPrestissimoProperties(patch)).To(BeEmpty())
+
+			patch.EngineProperties.Velox.VeloxProperty = []string{"shared-arbitrator.reserved-capacity=4GB", "num-io-threads=48"}
+			patch.EngineProperties.Catalog.CatalogName = []string{"iceberg_data", "hive.insert-overwrite-immutable-partitions-enabled=true"}
+			Expect(watsonxdatav2.ValidatePrestissimoEnginePatch(patch)).To(Succeed())
+			Expect(watsonxdatav2.UnknownPrestissimoProperties(patch)).To(Equal([]string{"velox.shared-arbitrator.reserved-capacity", "catalog.hive.insert-overwrite-immutable-partitions-enabled"}))
+
+			patch.EngineProperties.Velox.VeloxProperty = []string{"num-io-threads", "bogus=1", "query-memory-gb=60", "system-memory-gb=40"}
+			patch.EngineProperties.Catalog.CatalogName = []string{"max-partitions-per-writers=lots"}
+			err := watsonxdatav2.ValidatePrestissimoEnginePatch(patch)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("'num-io-threads' must be of the form name=value"))
+			Expect(err.Error()).ToNot(ContainSubstring("bogus"))
+			Expect(err.Error()).To(ContainSubstring("query-memory-gb (60) must not exceed system-memory-gb (40)"))
+			Expect(err.Error()).To(ContainSubstring("max-partitions-per-writers must be of type integer"))
+		})
+		It(`Expand tuning profiles`, func() {
+			Expect(watsonxdatav2.PrestissimoTuningProfiles()).To(HaveLen(3))
+			for _, profile := range watsonxdatav2.PrestissimoTuningProfiles() {
+				entries, err := watsonxdatav2.ExpandPrestissimoTuningProfile(profile.Name)
+				Expect(err).To(BeNil())
+				patch := &watsonxdatav2.PrestissimoEnginePatch{
+					EngineProperties: &watsonxdatav2.PrestissimoEngineEngineProperties{
+						Velox: &watsonxdatav2.PrestissimoEnginePropertiesVelox{VeloxProperty: entries},
+					},
+				}
+				Expect(watsonxdatav2.ValidatePrestissimoEnginePatch(patch)).To(Succeed(), profile.Name)
+			}
+
+			entries, err := watsonxdatav2.ExpandPrestissimoTuningProfile(watsonxdatav2.PrestissimoTuningProfile_IoHeavy)
+			Expect(err).To(BeNil())
+			Expect(entries).To(Equal([]string{"async-data-cache-enabled=true", "num-io-threads=64"}))
+
+			_, err = watsonxdatav2.ExpandPrestissimoTuningProfile("turbo")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`ValidateAndUpdatePrestissimoEngine(validateAndUpdatePrestissimoEngineOptions *ValidateAndUpdatePrestissimoEngineOptions)`, func() {
+		var testServer *httptest.Server
+		var patches []map[string]interface{}
+		var engineReads int
+		BeforeEach(func() {
+			patches = nil
+			engineReads = 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/prestissimo_engines/prestissimo01"))
+				if req.Method == "GET" {
+					engineReads++
+					res.Header().Set("Content-type", "application/json")
+					fmt.Fprint(res, `{"engine_id": "prestissimo01", "engine_properties": {"velox": {"velox_property": ["system-memory-gb=48"]}}}`)
+					return
+				}
+				Expect(req.Method).To(Equal("PATCH"))
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				patches = append(patches, body)
+				res.Header().Set("Content-type", "application/json")
+				fmt.Fprint(res, `{"engine_id": "prestissimo01", "external_host_name": "h", "status_code": 200}`)
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Expand the profile and send the validated patch`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			result, response, operationErr := watsonxDataService.ValidateAndUpdatePrestissimoEngine(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+
+			patch := &watsonxdatav2.PrestissimoEnginePatch{
+				EngineDisplayName: core.StringPtr("tuned"),
+				EngineProperties: &watsonxdatav2.PrestissimoEngineEngineProperties{
+					Velox: &watsonxdatav2.PrestissimoEnginePropertiesVelox{VeloxProperty: []string{"num-io-threads=96"}},
+				},
+			}
+			validateAndUpdatePrestissimoEngineOptionsModel := watsonxDataService.NewValidateAndUpdatePrestissimoEngineOptions("prestissimo01")
+			validateAndUpdatePrestissimoEngineOptionsModel.SetPatch(patch)
+			validateAndUpdatePrestissimoEngineOptionsModel.SetProfile(watsonxdatav2.PrestissimoTuningProfile_IoHeavy)
+			result, response, operationErr = watsonxDataService.ValidateAndUpdatePrestissimoEngine(validateAndUpdatePrestissimoEngineOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(*result.EngineID).To(Equal("prestissimo01"))
+			Expect(patches).To(Equal([]map[string]interface{}{{
+				"engine_display_name": "tuned",
+				"engine_properties": map[string]interface{}{
+					"velox": map[string]interface{}{
+						"velox_property": []interface{}{"num-io-threads=96", "async-data-cache-enabled=true"},
+					},
+				},
+			}}))
+			Expect(patch.EngineProperties.Velox.VeloxProperty).To(Equal([]string{"num-io-threads=96"}))
+			Expect(engineReads).To(Equal(0))
+		})
+		It(`Check a single memory property against the engine`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			validateAndUpdatePrestissimoEngineOptionsModel := watsonxDataService.NewValidateAndUpdatePrestissimoEngineOptions("prestissimo01")
+			validateAndUpdatePrestissimoEngineOptionsModel.SetPatch(&watsonxdatav2.PrestissimoEnginePatch{
+				EngineProperties: &watsonxdatav2.PrestissimoEngineEngineProperties{
+					Velox: &watsonxdatav2.PrestissimoEnginePropertiesVelox{VeloxProperty: []string{"query-memory-gb=50"}},
+				},
+			})
+			result, _, operationErr := watsonxDataService.ValidateAndUpdatePrestissimoEngine(validateAndUpdatePrestissimoEngineOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("query-memory-gb (50) must not exceed system-memory-gb (48)"))
+			Expect(result).To(BeNil())
+			Expect(patches).To(BeEmpty())
+
+			// The engine does not set query-memory-gb, so its default of 38 applies
+			validateAndUpdatePrestissimoEngineOptionsModel.SetPatch(&watsonxdatav2.PrestissimoEnginePatch{
+				EngineProperties: &watsonxdatav2.PrestissimoEngineEngineProperties{
+					Velox: &watsonxdatav2.PrestissimoEnginePropertiesVelox{VeloxProperty: []string{"system-memory-gb=32"}},
+				},
+			})
+			_, _, operationErr = watsonxDataService.ValidateAndUpdatePrestissimoEngine(validateAndUpdatePrestissimoEngineOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("query-memory-gb (38) must not exceed system-memory-gb (32)"))
+
+			validateAndUpdatePrestissimoEngineOptionsModel.SetPatch(&watsonxdatav2.PrestissimoEnginePatch{
+				EngineProperties: &watsonxdatav2.PrestissimoEngineEngineProperties{
+					Velox: &watsonxdatav2.PrestissimoEnginePropertiesVelox{VeloxProperty: []string{"query-memory-gb=40"}},
+				},
+			})
+			result, _, operationErr = watsonxDataService.ValidateAndUpdatePrestissimoEngine(validateAndUpdatePrestissimoEngineOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(*result.EngineID).To(Equal("prestissimo01"))
+			Expect(patches).To(HaveLen(1))
+			Expect(engineReads).To(Equal(3))
+		})
+		It(`Send nothing for an invalid patch`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			validateAndUpdatePrestissimoEngineOptionsModel := watsonxDataService.NewValidateAndUpdatePrestissimoEngineOptions("prestissimo01")
+			validateAndUpdatePrestissimoEngineOptionsModel.SetPatch(&watsonxdatav2.PrestissimoEnginePatch{
+				EngineProperties: &watsonxdatav2.PrestissimoEngineEngineProperties{
+					Velox: &watsonxdatav2.PrestissimoEnginePropertiesVelox{VeloxProperty: []string{"task.max-drivers-per-task=0"}},
+				},
+			})
+			result, _, operationErr := watsonxDataService.ValidateAndUpdatePrestissimoEngine(validateAndUpdatePrestissimoEngineOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			validateAndUpdatePrestissimoEngineOptionsModel.SetPatch(nil)
+			validateAndUpdatePrestissimoEngineOptionsModel.SetProfile("turbo")
+			result, _, operationErr = watsonxDataService.ValidateAndUpdatePrestissimoEngine(validateAndUpdatePrestissimoEngineOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+			Expect(patches).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,607 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// prestoEngineNodeSections are the engine property sections that are set separately for the coordinator and the
+// workers.
+var prestoEngineNodeSections = map[string]bool{
+	"configuration": true,
+	"jvm":           true,
+	"log_config":    true,
+}
+
+// prestoEngineSections are the engine property sections that apply to the whole engine.
+var prestoEngineSections = map[string]bool{
+	"event_listener":      true,
+	"global":              true,
+	"jmx_exporter_config": true,
+}
+
+// PrestoEngineProperties : Flat view of the engine properties of a Presto engine.
+// Keys are the section followed by the property name, for example "global.query.max-memory" or
+// "event_listener.event-listener.name". The configuration, jvm and log_config sections take a coordinator or worker
+// node between them, as in "configuration.coordinator.query.max-memory-per-node" or "jvm.worker.node_type". The
+// property name is everything after the section, or after the node, and may contain dots. The catalog section only
+// holds catalog_name. Node quantities must be integers.
+type PrestoEngineProperties map[string]string
+
+// NewPrestoEngineProperties : Flatten the engine_properties object of a Presto engine
+// Values that are not strings keep their JSON text, so a quantity of 2 becomes "2". Sections other than the ones of
+// PrestoEngineProperties are skipped. An empty or null object returns an empty map.
+func NewPrestoEngineProperties(engineProperties json.RawMessage) (properties PrestoEngineProperties, err error) {
+	properties = PrestoEngineProperties{}
+	if len(engineProperties) == 0 || string(engineProperties) == "null" {
+		return
+	}
+	var sections map[string]json.RawMessage
+	if err = json.Unmarshal(engineProperties, &sections); err != nil {
+		err = core.SDKErrorf(err, "", "engine-properties-error", common.GetComponentInfo())
+		return nil, err
+	}
+	for section, raw := range sections {
+		if section != "catalog" && !prestoEngineSections[section] && !prestoEngineNodeSections[section] {
+			continue
+		}
+		var values map[string]json.RawMessage
+		if err = json.Unmarshal(raw, &values); err != nil {
+			err = core.SDKErrorf(err, "", "engine-properties-error", common.GetComponentInfo())
+			return nil, err
+		}
+		switch {
+		case section == "catalog":
+			if value, ok := values["catalog_name"]; ok {
+				properties["catalog.catalog_name"] = prestoEnginePropertyText(value)
+			}
+		case prestoEngineSections[section]:
+			for name, value := range values {
+				properties[section+"."+name] = prestoEnginePropertyText(value)
+			}
+		case prestoEngineNodeSections[section]:
+			for _, node := range []string{"coordinator", "worker"} {
+				var nodeValues map[string]json.RawMessage
+				if raw, ok := values[node]; !ok || string(raw) == "null" {
+					continue
+				} else if err = json.Unmarshal(raw, &nodeValues); err != nil {
+					err = core.SDKErrorf(err, "", "engine-properties-error", common.GetComponentInfo())
+					return nil, err
+				}
+				for name, value := range nodeValues {
+					properties[section+"."+node+"."+name] = prestoEnginePropertyText(value)
+				}
+			}
+		}
+	}
+	return
+}
+
+// prestoEnginePropertyText returns a JSON string value, or the JSON text of any other value.
+func prestoEnginePropertyText(value json.RawMessage) string {
+	var text string
+	if json.Unmarshal(value, &text) != nil {
+		text = string(value)
+	}
+	return text
+}
+
+// LoadPrestoEngineProperties : Read Presto engine properties from a properties file
+// See ReadPrestoEngineProperties for the file format.
+func LoadPrestoEngineProperties(path string) (properties PrestoEngineProperties, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "properties-file-error", common.GetComponentInfo())
+		return
+	}
+	defer file.Close()
+	return ReadPrestoEngineProperties(file)
+}
+
+// ReadPrestoEngineProperties : Read Presto engine properties in properties file format
+// Each line holds one key=value, key: value or key value pair. Blank lines and lines starting with # or ! are
+// ignored, a line ending in a backslash continues on the next line, and a repeated key overrides the earlier value.
+// Every key is validated.
+func ReadPrestoEngineProperties(reader io.Reader) (properties PrestoEngineProperties, err error) {
+	values, err := readPropertiesFile(reader)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "properties-file-error", common.GetComponentInfo())
+		return
+	}
+	properties = PrestoEngineProperties(values)
+	if err = properties.Validate(); err != nil {
+		properties = nil
+	}
+	return
+}
+
+// Validate : Check that every key belongs to a Presto engine property section and that quantities are integers.
+func (properties PrestoEngineProperties) Validate() error {
+	_, err := properties.engineProperties()
+	return err
+}
+
+// engineProperties returns the properties as an engine_properties object.
+func (properties PrestoEngineProperties) engineProperties() (engineProperties map[string]interface{}, err error) {
+	engineProperties = map[string]interface{}{}
+	for _, key := range properties.keys() {
+		section, node, name, splitErr := splitPrestoEnginePropertyKey(key)
+		if splitErr != nil {
+			return nil, core.SDKErrorf(splitErr, "", "invalid-engine-property", common.GetComponentInfo())
+		}
+		values, _ := engineProperties[section].(map[string]interface{})
+		if values == nil {
+			values = map[string]interface{}{}
+			engineProperties[section] = values
+		}
+		var value interface{} = properties[key]
+		if node != "" {
+			nodeValues, _ := values[node].(map[string]interface{})
+			if nodeValues == nil {
+				nodeValues = map[string]interface{}{}
+				values[node] = nodeValues
+			}
+			values = nodeValues
+			if name == "quantity" {
+				quantity, parseErr := strconv.ParseInt(strings.TrimSpace(properties[key]), 10, 64)
+				if parseErr != nil {
+					err = fmt.Errorf("property '%s' must be an integer, got '%s'", key, properties[key])
+					return nil, core.SDKErrorf(err, "", "invalid-engine-property", common.GetComponentInfo())
+				}
+				value = quantity
+			}
+		}
+		values[name] = value
+	}
+	return
+}
+
+// Diff : Compute the changes that turn properties into desired.
+func (properties PrestoEngineProperties) Diff(desired PrestoEngineProperties) *PrestoEnginePropertiesDiff {
+	diff := new(PrestoEnginePropertiesDiff)
+	for _, key := range properties.keys() {
+		newValue, ok := desired[key]
+		if !ok {
+			diff.Changes = append(diff.Changes, PrestoEnginePropertyChange{
+				Key:      key,
+				Action:   PrestoEnginePropertyChange_Action_Remove,
+				OldValue: properties[key],
+			})
+		} else if newValue != properties[key] {
+			diff.Changes = append(diff.Changes, PrestoEnginePropertyChange{
+				Key:      key,
+				Action:   PrestoEnginePropertyChange_Action_Change,
+				OldValue: properties[key],
+				NewValue: newValue,
+			})
+		}
+	}
+	for _, key := range desired.keys() {
+		if _, ok := properties[key]; !ok {
+			diff.Changes = append(diff.Changes, PrestoEnginePropertyChange{
+				Key:      key,
+				Action:   PrestoEnginePropertyChange_Action_Add,
+				NewValue: desired[key],
+			})
+		}
+	}
+	sort.SliceStable(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].Key < diff.Changes[j].Key
+	})
+	return diff
+}
+
+// keys returns the property keys in sorted order.
+func (properties PrestoEngineProperties) keys() []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// splitPrestoEnginePropertyKey splits a flat property key into its section, node and property name. Node is empty
+// for sections that are not split by coordinator and worker.
+func splitPrestoEnginePropertyKey(key string) (section string, node string, name string, err error) {
+	section, name, _ = strings.Cut(key, ".")
+	switch {
+	case section == "catalog":
+		if name == "catalog_name" {
+			return
+		}
+	case prestoEngineSections[section]:
+		if name != "" {
+			return
+		}
+	case prestoEngineNodeSections[section]:
+		node, name, _ = strings.Cut(name, ".")
+		if (node == "coordinator" || node == "worker") && name != "" {
+			return
+		}
+	}
+	err = fmt.Errorf("unknown Presto engine property '%s'", key)
+	return "", "", "", err
+}
+
+// PrestoEnginePropertyChange : One property that differs between two sets of Presto engine properties.
+type PrestoEnginePropertyChange struct {
+	// Flat property key.
+	Key string
+
+	// Whether the property is added, changed or removed.
+	Action string
+
+	// Current value. Empty for added properties.
+	OldValue string
+
+	// Desired value. Empty for removed properties.
+	NewValue string
+}
+
+// Constants associated with the PrestoEnginePropertyChange.Action property.
+// Whether the property is added, changed or removed.
+const (
+	PrestoEnginePropertyChange_Action_Add    = "add"
+	PrestoEnginePropertyChange_Action_Change = "change"
+	PrestoEnginePropertyChange_Action_Remove = "remove"
+)
+
+// String returns the change as "+ key = new", "~ key = old -> new" or "- key = old".
+func (change PrestoEnginePropertyChange) String() string {
+	switch change.Action {
+	case PrestoEnginePropertyChange_Action_Add:
+		return fmt.Sprintf("+ %s = %s", change.Key, change.NewValue)
+	case PrestoEnginePropertyChange_Action_Remove:
+		return fmt.Sprintf("- %s = %s", change.Key, change.OldValue)
+	}
+	return fmt.Sprintf("~ %s = %s -> %s", change.Key, change.OldValue, change.NewValue)
+}
+
+// PrestoEnginePropertiesDiff : The changes between the current and the desired Presto engine properties.
+type PrestoEnginePropertiesDiff struct {
+	// Changes, ordered by key.
+	Changes []PrestoEnginePropertyChange
+}
+
+// Empty returns true if the current and desired properties are the same.
+func (diff *PrestoEnginePropertiesDiff) Empty() bool {
+	return len(diff.Changes) == 0
+}
+
+// String returns one line per change, or "no changes".
+func (diff *PrestoEnginePropertiesDiff) String() string {
+	if diff.Empty() {
+		return "no changes\n"
+	}
+	builder := new(strings.Builder)
+	for _, change := range diff.Changes {
+		fmt.Fprintln(builder, change)
+	}
+	return builder.String()
+}
+
+// Patch : Return the body of an UpdatePrestoEngine call that applies the diff
+// Added and changed properties are set in engine_properties; removed properties are listed by name in
+// remove_engine_properties. A removed catalog is identified by its current name.
+func (diff *PrestoEnginePropertiesDiff) Patch() (patch map[string]interface{}, err error) {
+	patch = map[string]interface{}{}
+	set := PrestoEngineProperties{}
+	remove := map[string]interface{}{}
+	for _, change := range diff.Changes {
+		if change.Action != PrestoEnginePropertyChange_Action_Remove {
+			set[change.Key] = change.NewValue
+			continue
+		}
+		section, node, name, splitErr := splitPrestoEnginePropertyKey(change.Key)
+		if splitErr != nil {
+			return nil, core.SDKErrorf(splitErr, "", "invalid-engine-property", common.GetComponentInfo())
+		}
+		switch {
+		case section == "catalog":
+			remove[section] = map[string]interface{}{"catalog_name": change.OldValue}
+		case node == "":
+			names, _ := remove[section].([]string)
+			remove[section] = append(names, name)
+		default:
+			nodes, _ := remove[section].(map[string][]string)
+			if nodes == nil {
+				nodes = map[string][]string{}
+				remove[section] = nodes
+			}
+			nodes[node] = append(nodes[node], name)
+		}
+	}
+	if len(set) > 0 {
+		if patch["engine_properties"], err = set.engineProperties(); err != nil {
+			return nil, err
+		}
+	}
+	if len(remove) > 0 {
+		patch["remove_engine_properties"] = remove
+	}
+	return
+}
+
+// UpdatePrestoEngineProperties : Update the engine properties of a Presto engine to a desired state
+// Get the engine, compute the diff between its current and the desired properties, write the diff to Output and,
+// unless DryRun is set, send the patch that applies it. Nothing is sent if there are no changes.
+func (watsonxData *WatsonxDataV2) UpdatePrestoEngineProperties(updatePrestoEnginePropertiesOptions *UpdatePrestoEnginePropertiesOptions) (result *PrestoEnginePropertiesUpdate, err error) {
+	result, err = watsonxData.UpdatePrestoEnginePropertiesWithContext(context.Background(), updatePrestoEnginePropertiesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// UpdatePrestoEnginePropertiesWithContext is an alternate form of the UpdatePrestoEngineProperties method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) UpdatePrestoEnginePropertiesWithContext(ctx context.Context, updatePrestoEnginePropertiesOptions *UpdatePrestoEnginePropertiesOptions) (result *PrestoEnginePropertiesUpdate, err error) {
+	err = core.ValidateNotNil(updatePrestoEnginePropertiesOptions, "updatePrestoEnginePropertiesOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updatePrestoEnginePropertiesOptions, "updatePrestoEnginePropertiesOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := updatePrestoEnginePropertiesOptions
+	if err = opts.Properties.Validate(); err != nil {
+		return
+	}
+
+	engine, rawEngine, err := watsonxData.getPrestoEngineJSON(ctx, opts)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-engine-error")
+		return
+	}
+	current, err := NewPrestoEngineProperties(rawEngine["engine_properties"])
+	if err != nil {
+		return
+	}
+
+	result = &PrestoEnginePropertiesUpdate{
+		Diff:   current.Diff(opts.Properties),
+		Engine: engine,
+		DryRun: opts.DryRun != nil && *opts.DryRun,
+	}
+	if opts.Output != nil {
+		fmt.Fprint(opts.Output, result.Diff)
+	}
+	if result.DryRun || result.Diff.Empty() {
+		return
+	}
+
+	body, err := result.Diff.Patch()
+	if err != nil {
+		return
+	}
+	if opts.EngineRestart != nil {
+		body["engine_restart"] = *opts.EngineRestart
+	}
+	updated, _, err := watsonxData.UpdatePrestoEngineWithContext(ctx, &UpdatePrestoEngineOptions{
+		EngineID:       opts.EngineID,
+		Body:           body,
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "update-engine-error")
+		return
+	}
+	result.Engine = updated
+	result.Applied = true
+	return
+}
+
+// getPrestoEngineJSON gets a Presto engine like GetPrestoEngine and also returns its JSON object. The engine
+// property models only have fields for sample keys, so the engine properties are read from the JSON.
+func (watsonxData *WatsonxDataV2) getPrestoEngineJSON(ctx context.Context, opts *UpdatePrestoEnginePropertiesOptions) (engine *PrestoEngine, rawEngine map[string]json.RawMessage, err error) {
+	pathParamsMap := map[string]string{
+		"engine_id": *opts.EngineID,
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = watsonxData.GetEnableGzipCompression()
+	_, err = builder.ResolveRequestURL(watsonxData.Service.Options.URL, `/presto_engines/{engine_id}`, pathParamsMap)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+	for headerName, headerValue := range opts.Headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	for headerName, headerValue := range common.GetSdkHeaders("watsonx_data", "V2", "GetPrestoEngine") {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	if opts.AuthInstanceID != nil {
+		builder.AddHeader("AuthInstanceId", fmt.Sprint(*opts.AuthInstanceID))
+	}
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+
+	_, err = watsonxData.Service.Request(request, &rawEngine)
+	if err != nil {
+		core.EnrichHTTPProblem(err, "get_presto_engine", getServiceComponentInfo())
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(rawEngine, "", &engine, UnmarshalPrestoEngine)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unmarshal-resp-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// PrestoEnginePropertiesUpdate : Outcome of UpdatePrestoEngineProperties.
+type PrestoEnginePropertiesUpdate struct {
+	// Changes between the current and the desired properties.
+	Diff *PrestoEnginePropertiesDiff
+
+	// The engine as returned by the update, or as it was before if nothing was sent.
+	Engine *PrestoEngine
+
+	// True if the patch was sent.
+	Applied bool
+
+	// True if the diff was only computed.
+	DryRun bool
+}
+
+// UpdatePrestoEnginePropertiesOptions : The UpdatePrestoEngineProperties options.
+type UpdatePrestoEnginePropertiesOptions struct {
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// The complete desired set of engine properties. Current properties missing from it are removed.
+	Properties PrestoEngineProperties `json:"properties" validate:"required"`
+
+	// Triggers engine restart if value is force.
+	EngineRestart *string `json:"engine_restart,omitempty"`
+
+	// Only compute and print the diff, without updating the engine.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// If set, the diff is written to Output before the engine is updated.
+	Output io.Writer
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// Constants associated with the UpdatePrestoEnginePropertiesOptions.EngineRestart property.
+// Triggers engine restart if value is force.
+const (
+	UpdatePrestoEnginePropertiesOptions_EngineRestart_False = "false"
+	UpdatePrestoEnginePropertiesOptions_EngineRestart_Force = "force"
+)
+
+// NewUpdatePrestoEnginePropertiesOptions : Instantiate UpdatePrestoEnginePropertiesOptions
+func (*WatsonxDataV2) NewUpdatePrestoEnginePropertiesOptions(engineID string, properties PrestoEngineProperties) *UpdatePrestoEnginePropertiesOptions {
+	return &UpdatePrestoEnginePropertiesOptions{
+		EngineID:   core.StringPtr(engineID),
+		Properties: properties,
+	}
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *UpdatePrestoEnginePropertiesOptions) SetEngineID(engineID string) *UpdatePrestoEnginePropertiesOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetProperties : Allow user to set Properties
+func (_options *UpdatePrestoEnginePropertiesOptions) SetProperties(properties PrestoEngineProperties) *UpdatePrestoEnginePropertiesOptions {
+	_options.Properties = properties
+	return _options
+}
+
+// SetEngineRestart : Allow user to set EngineRestart
+func (_options *UpdatePrestoEnginePropertiesOptions) SetEngineRestart(engineRestart string) *UpdatePrestoEnginePropertiesOptions {
+	_options.EngineRestart = core.StringPtr(engineRestart)
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *UpdatePrestoEnginePropertiesOptions) SetDryRun(dryRun bool) *UpdatePrestoEnginePropertiesOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetOutput : Allow user to set Output
+func (_options *UpdatePrestoEnginePropertiesOptions) SetOutput(output io.Writer) *UpdatePrestoEnginePropertiesOptions {
+	_options.Output = output
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *UpdatePrestoEnginePropertiesOptions) SetAuthInstanceID(authInstanceID string) *UpdatePrestoEnginePropertiesOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdatePrestoEnginePropertiesOptions) SetHeaders(param map[string]string) *UpdatePrestoEnginePropertiesOptions {
+	options.Headers = param
+	return options
+}
+
+// readPropertiesFile parses properties file content into a map. Keys end at the first "=", ":" or whitespace, as
+// in Java properties files and spark-defaults.conf.
+func readPropertiesFile(reader io.Reader) (values map[string]string, err error) {
+	values = map[string]string{}
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+	logical := ""
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if logical == "" && (line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!")) {
+			continue
+		}
+		if strings.HasSuffix(line, "\\") {
+			logical += strings.TrimSuffix(line, "\\")
+			continue
+		}
+		logical += line
+
+		separator := strings.IndexAny(logical, "=: \t")
+		if separator < 0 {
+			return nil, fmt.Errorf("line %d: expected key=value, got '%s'", lineNumber, logical)
+		}
+		key := logical[:separator]
+		if key == "" {
+			return nil, fmt.Errorf("line %d: empty key", lineNumber)
+		}
+		value := strings.TrimSpace(logical[separator:])
+		if logical[separator] == ' ' || logical[separator] == '\t' {
+			if strings.HasPrefix(value, "=") || strings.HasPrefix(value, ":") {
+				value = strings.TrimSpace(value[1:])
+			}
+		} else {
+			value = strings.TrimSpace(logical[separator+1:])
+		}
+		values[key] = value
+		logical = ""
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	if logical != "" {
+		return nil, fmt.Errorf("line %d: unterminated continuation line", lineNumber)
+	}
+	return
+}
@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 Presto engine properties`, func() {
+	const desiredProperties = `
+# desired engine properties
+configuration.coordinator.node_type = bx2.16x64
+configuration.coordinator.quantity: 2
+configuration.coordinator.query.max-memory-per-node=8GB
+global.query.max-memory 120GB
+jvm.worker.node_type=\
+  bx2.4x16
+`
+	Describe(`PrestoEngineProperties`, func() {
+		It(`Read properties file content`, func() {
+			properties, err := watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader(desiredProperties))
+			Expect(err).To(BeNil())
+			Expect(properties).To(Equal(watsonxdatav2.PrestoEngineProperties{
+				"configuration.coordinator.node_type":                 "bx2.16x64",
+				"configuration.coordinator.quantity":                  "2",
+				"configuration.coordinator.query.max-memory-per-node": "8GB",
+				"global.query.max-memory":                             "120GB",
+				"jvm.worker.node_type":                                "bx2.4x16",
+			}))
+
+			_, err = watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader("jvm.master.node_type=x\n"))
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader("velox.query.max-memory=1GB\n"))
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader("catalog.hive.metastore=thrift\n"))
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader("configuration.worker=x\n"))
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader("jvm.worker.quantity=two\n"))
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader("no separator\n"))
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Load properties from a file`, func() {
+			dir, err := os.MkdirTemp("", "presto-properties")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "engine.properties")
+			Expect(os.WriteFile(path, []byte(`# engine.properties
+global.query.max-memory=120GB
+global.query.max-total-memory = 160GB
+configuration.coordinator.query.max-memory-per-node=8GB
+configuration.worker.exchange.http-client.max-connections-per-server: 1000
+jvm.coordinator.-Xmx 48G
+log_config.worker.com.facebook.presto=WARN
+event_listener.event-listener.name=query-logger
+catalog.catalog_name=iceberg_data
+`), 0600)).To(Succeed())
+			properties, err := watsonxdatav2.LoadPrestoEngineProperties(path)
+			Expect(err).To(BeNil())
+			Expect(properties).To(Equal(watsonxdatav2.PrestoEngineProperties{
+				"global.query.max-memory":                                              "120GB",
+				"global.query.max-total-memory":                                        "160GB",
+				"configuration.coordinator.query.max-memory-per-node":                  "8GB",
+				"configuration.worker.exchange.http-client.max-connections-per-server": "1000",
+				"jvm.coordinator.-Xmx":                                                 "48G",
+				"log_config.worker.com.facebook.presto":                                "WARN",
+				"event_listener.event-listener.name":                                   "query-logger",
+				"catalog.catalog_name":                                                 "iceberg_data",
+			}))
+
+			_, err = watsonxdatav2.LoadPrestoEngineProperties(filepath.Join(dir, "missing.properties"))
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Flatten the engine properties of an engine`, func() {
+			properties, err := watsonxdatav2.NewPrestoEngineProperties([]byte(`{
+				"catalog": {"catalog_name": "iceberg_data"},
+				"configuration": {"coordinator": {"node_type": "bx2.16x64", "quantity": 1, "query.max-memory-per-node": "8GB"}, "worker": null},
+				"global": {"query.max-memory": "120GB"},
+				"velox": ["ignored"]
+			}`))
+			Expect(err).To(BeNil())
+			Expect(properties).To(Equal(watsonxdatav2.PrestoEngineProperties{
+				"catalog.catalog_name":                                "iceberg_data",
+				"configuration.coordinator.node_type":                 "bx2.16x64",
+				"configuration.coordinator.quantity":                  "1",
+				"configuration.coordinator.query.max-memory-per-node": "8GB",
+				"global.query.max-memory":                             "120GB",
+			}))
+			Expect(properties.Validate()).To(Succeed())
+
+			properties, err = watsonxdatav2.NewPrestoEngineProperties(nil)
+			Expect(err).To(BeNil())
+			Expect(properties).To(BeEmpty())
+			_, err = watsonxdatav2.NewPrestoEngineProperties([]byte(`{"global": "x"}`))
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Compute the patch for a diff`, func() {
+			current := watsonxdatav2.PrestoEngineProperties{
+				"configuration.coordinator.node_type":                 "bx2.16x64",
+				"configuration.coordinator.quantity":                  "1",
+				"configuration.coordinator.query.max-memory-per-node": "4GB",
+				"configuration.worker.quantity":                       "4",
+				"configuration.worker.task.concurrency":               "16",
+				"global.query.max-memory":                             "100GB",
+				"global.global_property":                              "enabled",
+				"catalog.catalog_name":                                "hive_data",
+			}
+			desired := watsonxdatav2.PrestoEngineProperties{
+				"configuration.coordinator.node_type":                 "bx2.16x64",
+				"configuration.coordinator.quantity":                  "2",
+				"configuration.coordinator.query.max-memory-per-node": "8GB",
+				"global.query.max-memory":                             "100GB",
+				"jvm.worker.node_type":                                "bx2.4x16",
+			}
+			diff := current.Diff(desired)
+			Expect(diff.String()).To(Equal("" +
+				"- catalog.catalog_name = hive_data\n" +
+				"~ configuration.coordinator.quantity = 1 -> 2\n" +
+				"~ configuration.coordinator.query.max-memory-per-node = 4GB -> 8GB\n" +
+				"- configuration.worker.quantity = 4\n" +
+				"- configuration.worker.task.concurrency = 16\n" +
+				"- global.global_property = enabled\n" +
+				"+ jvm.worker.node_type = bx2.4x16\n"))
+
+			patch, err := diff.Patch()
+			Expect(err).To(BeNil())
+			Expect(patch).To(Equal(map[string]interface{}{
+				"engine_properties": map[string]interface{}{
+					"configuration": map[string]interface{}{
+						"coordinator": map[string]interface{}{"quantity": int64(2), "query.max-memory-per-node": "8GB"},
+					},
+					"jvm": map[string]interface{}{
+						"worker": map[string]interface{}{"node_type": "bx2.4x16"},
+					},
+				},
+				"remove_engine_properties": map[string]interface{}{
+					"catalog":       map[string]interface{}{"catalog_name": "hive_data"},
+					"configuration": map[string][]string{"worker": {"quantity", "task.concurrency"}},
+					"global":        []string{"global_property"},
+				},
+			}))
+
+			Expect(desired.Diff(desired).Empty()).To(BeTrue())
+			Expect(desired.Diff(desired).String()).To(Equal("no changes\n"))
+			patch, err = desired.Diff(desired).Patch()
+			Expect(err).To(BeNil())
+			Expect(patch).To(BeEmpty())
+		})
+	})
+	Describe(`UpdatePrestoEngineProperties(updatePrestoEnginePropertiesOptions *UpdatePrestoEnginePropertiesOptions)`, func() {
+		var testServer *httptest.Server
+		var patches []map[string]interface{}
+		BeforeEach(func() {
+			patches = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.URL.EscapedPath()).To(Equal("/presto_engines/presto01"))
+				res.Header().Set("Content-type", "application/json")
+				switch req.Method {
+				case "GET":
+					fmt.Fprint(res, `{"engine_id": "presto01", "external_host_name": "h", "status_code": 200, "engine_properties": {
+						"configuration": {"coordinator": {"node_type": "bx2.16x64", "quantity": 1, "query.max-memory-per-node": "8GB"}},
+						"global": {"global_property": "enabled", "query.max-memory": "100GB"}
+					}}`)
+				case "PATCH":
+					Expect(req.Header.Get("Content-Type")).To(Equal("application/merge-patch+json"))
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					patches = append(patches, body)
+					fmt.Fprint(res, `{"engine_id": "presto01", "external_host_name": "h", "status_code": 200}`)
+				default:
+					Fail("unexpected method " + req.Method)
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Print the diff in dry-run mode`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			result, operationErr := watsonxDataService.UpdatePrestoEngineProperties(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			desired, err := watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader(desiredProperties))
+			Expect(err).To(BeNil())
+			output := new(bytes.Buffer)
+			updatePrestoEnginePropertiesOptionsModel := watsonxDataService.NewUpdatePrestoEnginePropertiesOptions("presto01", desired)
+			updatePrestoEnginePropertiesOptionsModel.SetDryRun(true)
+			updatePrestoEnginePropertiesOptionsModel.SetOutput(output)
+			result, operationErr = watsonxDataService.UpdatePrestoEngineProperties(updatePrestoEnginePropertiesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.DryRun).To(BeTrue())
+			Expect(result.Applied).To(BeFalse())
+			Expect(patches).To(BeEmpty())
+			Expect(output.String()).To(Equal("" +
+				"~ configuration.coordinator.quantity = 1 -> 2\n" +
+				"- global.global_property = enabled\n" +
+				"~ global.query.max-memory = 100GB -> 120GB\n" +
+				"+ jvm.worker.node_type = bx2.4x16\n"))
+		})
+		It(`Send the patch with the remove list`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			desired, err := watsonxdatav2.ReadPrestoEngineProperties(strings.NewReader(desiredProperties))
+			Expect(err).To(BeNil())
+			updatePrestoEnginePropertiesOptionsModel := watsonxDataService.NewUpdatePrestoEnginePropertiesOptions("presto01", desired)
+			updatePrestoEnginePropertiesOptionsModel.SetEngineRestart(watsonxdatav2.UpdatePrestoEnginePropertiesOptions_EngineRestart_Force)
+			result, operationErr := watsonxDataService.UpdatePrestoEngineProperties(updatePrestoEnginePropertiesOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.Applied).To(BeTrue())
+			Expect(patches).To(HaveLen(1))
+			Expect(patches[0]).To(Equal(map[string]interface{}{
+				"engine_properties": map[string]interface{}{
+					"configuration": map[string]interface{}{
+						"coordinator": map[string]interface{}{"quantity": float64(2)},
+					},
+					"global": map[string]interface{}{"query.max-memory": "120GB"},
+					"jvm": map[string]interface{}{
+						"worker": map[string]interface{}{"node_type": "bx2.4x16"},
+					},
+				},
+				"remove_engine_properties": map[string]interface{}{
+					"global": []interface{}{"global_property"},
+				},
+				"engine_restart": "force",
+			}))
+
+			// Nothing is sent when the engine already has the desired properties
+			current := watsonxdatav2.PrestoEngineProperties{
+				"configuration.coordinator.node_type":                 "bx2.16x64",
+				"configuration.coordinator.quantity":                  "1",
+				"configuration.coordinator.query.max-memory-per-node": "8GB",
+				"global.global_property":                              "enabled",
+				"global.query.max-memory":                             "100GB",
+			}
+			result, operationErr = watsonxDataService.UpdatePrestoEngineProperties(watsonxDataService.NewUpdatePrestoEnginePropertiesOptions("presto01", current))
+			Expect(operationErr).To(BeNil())
+			Expect(result.Applied).To(BeFalse())
+			Expect(patches).To(HaveLen(1))
+
+			// Unknown keys are rejected before any call is made
+			result, operationErr = watsonxDataService.UpdatePrestoEngineProperties(watsonxDataService.NewUpdatePrestoEnginePropertiesOptions("presto01", watsonxdatav2.PrestoEngineProperties{"velox.x": "1"}))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+})
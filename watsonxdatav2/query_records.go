@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// writeRecordsJSON writes records, a slice of query records, to writer as an indented JSON array. A nil slice is
+// written as an empty array.
+func writeRecordsJSON(writer io.Writer, records interface{}) (err error) {
+	if value := reflect.ValueOf(records); value.Kind() == reflect.Slice && value.IsNil() {
+		records = []interface{}{}
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(records)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "records-json-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// writeRecordsCSV writes a header row of columns and then row(i) for each of the count records to writer as CSV.
+func writeRecordsCSV(writer io.Writer, columns []string, count int, row func(i int) []string) (err error) {
+	csvWriter := csv.NewWriter(writer)
+	err = csvWriter.Write(columns)
+	for i := 0; i < count && err == nil; i++ {
+		err = csvWriter.Write(row(i))
+	}
+	if err == nil {
+		csvWriter.Flush()
+		err = csvWriter.Error()
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "records-csv-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// formatRecordTime formats a record time for CSV in RFC 3339 format, or "" if it is not set.
+func formatRecordTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// formatRecordSeconds formats a record duration for CSV, or "" if it is not set.
+func formatRecordSeconds(seconds *float64) string {
+	if seconds == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*seconds, 'f', -1, 64)
+}
+
+// sortRecordsNewestFirst orders records, a slice of query records, by startTime(i), newest first, with records that
+// have not started at the end. Records with the same start time keep their order.
+func sortRecordsNewestFirst(records interface{}, startTime func(i int) *time.Time) {
+	sort.SliceStable(records, func(i, j int) bool {
+		a, b := startTime(i), startTime(j)
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.After(*b)
+	})
+}
@@ -0,0 +1,339 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// Defaults used by SafeRestartEngine.
+const (
+	defaultSafeRestartPollInterval = 5 * time.Second
+	defaultSafeRestartDrainTimeout = 10 * time.Minute
+	defaultSafeRestartStopTimeout  = time.Minute
+	defaultSafeRestartStartTimeout = 10 * time.Minute
+	defaultSafeRestartSmokeQuery   = "SELECT 1"
+)
+
+// SafeRestartResult : Outcome of SafeRestartEngine.
+type SafeRestartResult struct {
+	// Time spent waiting for the drain sources to reach zero.
+	DrainWait time.Duration
+
+	// True if the restart call was made.
+	Restarted bool
+
+	// Time spent waiting for the engine to leave the running state after the restart call.
+	StopWait time.Duration
+
+	// True if the engine was never seen leaving the running state before StopTimeout, so the restart may not have
+	// happened.
+	RestartUnconfirmed bool
+
+	// Time spent waiting for the engine to be running after the restart.
+	StartWait time.Duration
+
+	// Result of the smoke query.
+	SmokeQueryResult *ExecuteQueryCreatedBody
+}
+
+// SafeRestartEngine : Restart a Presto or Prestissimo engine after draining its work
+// Wait until every drain source reports zero load, for example running Spark applications from a
+// SparkApplicationsMetricsSource or queries counted by a caller-supplied source. Then restart the engine, wait for
+// its status to leave running and come back to running, and run the smoke query with CreateExecuteQuery. The restart
+// is asynchronous and may happen between two checks, so an engine still running when StopTimeout passes is not an
+// error, but RestartUnconfirmed is set in the result. The engine is not restarted if the drain times out.
+func (watsonxData *WatsonxDataV2) SafeRestartEngine(safeRestartEngineOptions *SafeRestartEngineOptions) (result *SafeRestartResult, err error) {
+	result, err = watsonxData.SafeRestartEngineWithContext(context.Background(), safeRestartEngineOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SafeRestartEngineWithContext is an alternate form of the SafeRestartEngine method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) SafeRestartEngineWithContext(ctx context.Context, safeRestartEngineOptions *SafeRestartEngineOptions) (result *SafeRestartResult, err error) {
+	err = core.ValidateNotNil(safeRestartEngineOptions, "safeRestartEngineOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(safeRestartEngineOptions, "safeRestartEngineOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := safeRestartEngineOptions
+	if *opts.EngineType != SafeRestartEngineOptions_EngineType_Presto && *opts.EngineType != SafeRestartEngineOptions_EngineType_Prestissimo {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported engine type '%s'", *opts.EngineType), "invalid-engine-type", common.GetComponentInfo())
+		return
+	}
+	pollInterval := durationOrDefault(opts.PollInterval, defaultSafeRestartPollInterval)
+	result = new(SafeRestartResult)
+
+	start := time.Now()
+	err = pollUntil(ctx, pollInterval, durationOrDefault(opts.DrainTimeout, defaultSafeRestartDrainTimeout), func() (bool, error) {
+		for _, source := range opts.DrainSources {
+			load, err := source.Load(ctx)
+			if err != nil || load > 0 {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+	result.DrainWait = time.Since(start)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("engine %s did not drain: %s", *opts.EngineID, err.Error()), "drain-error", common.GetComponentInfo())
+		return
+	}
+
+	if *opts.EngineType == SafeRestartEngineOptions_EngineType_Presto {
+		_, _, err = watsonxData.RestartPrestoEngineWithContext(ctx, &RestartPrestoEngineOptions{EngineID: opts.EngineID, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	} else {
+		_, _, err = watsonxData.RestartPrestissimoEngineWithContext(ctx, &RestartPrestissimoEngineOptions{EngineID: opts.EngineID, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "restart-error")
+		return
+	}
+	result.Restarted = true
+
+	start = time.Now()
+	var statusErr error
+	err = pollUntil(ctx, pollInterval, durationOrDefault(opts.StopTimeout, defaultSafeRestartStopTimeout), func() (bool, error) {
+		var status string
+		status, statusErr = watsonxData.engineStatus(ctx, opts)
+		return status != "running", statusErr
+	})
+	result.StopWait = time.Since(start)
+	// A stop timeout is not an error, the engine may have restarted between polls.
+	if stopErr := statusErr; stopErr != nil || ctx.Err() != nil {
+		if stopErr == nil {
+			stopErr = ctx.Err()
+		}
+		err = core.SDKErrorf(stopErr, fmt.Sprintf("engine %s did not stop after the restart: %s", *opts.EngineID, stopErr.Error()), "stop-error", common.GetComponentInfo())
+		return
+	}
+	result.RestartUnconfirmed = err != nil
+	err = nil
+
+	start = time.Now()
+	err = pollUntil(ctx, pollInterval, durationOrDefault(opts.StartTimeout, defaultSafeRestartStartTimeout), func() (bool, error) {
+		status, err := watsonxData.engineStatus(ctx, opts)
+		return status == "running", err
+	})
+	result.StartWait = time.Since(start)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("engine %s is not running after the restart: %s", *opts.EngineID, err.Error()), "start-error", common.GetComponentInfo())
+		return
+	}
+
+	smokeQuery := defaultSafeRestartSmokeQuery
+	if opts.SmokeQuery != nil {
+		smokeQuery = *opts.SmokeQuery
+	}
+	result.SmokeQueryResult, _, err = watsonxData.CreateExecuteQueryWithContext(ctx, &CreateExecuteQueryOptions{
+		EngineID:       opts.EngineID,
+		SqlString:      core.StringPtr(smokeQuery),
+		CatalogName:    opts.SmokeQueryCatalog,
+		SchemaName:     opts.SmokeQuerySchema,
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "smoke-query-error")
+	}
+	return
+}
+
+// engineStatus returns the status of the engine being restarted.
+func (watsonxData *WatsonxDataV2) engineStatus(ctx context.Context, opts *SafeRestartEngineOptions) (status string, err error) {
+	if *opts.EngineType == SafeRestartEngineOptions_EngineType_Presto {
+		var engine *PrestoEngine
+		engine, _, err = watsonxData.GetPrestoEngineWithContext(ctx, &GetPrestoEngineOptions{EngineID: opts.EngineID, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+		if err == nil {
+			status = core.StringNilMapper(engine.Status)
+		}
+		return
+	}
+	var engine *PrestissimoEngine
+	engine, _, err = watsonxData.GetPrestissimoEngineWithContext(ctx, &GetPrestissimoEngineOptions{EngineID: opts.EngineID, AuthInstanceID: opts.AuthInstanceID, Headers: opts.Headers})
+	if err == nil {
+		status = core.StringNilMapper(engine.Status)
+	}
+	return
+}
+
+// pollUntil calls done every interval until it returns true, returns an error, the timeout passes or ctx is done.
+// The first call is made immediately.
+func pollUntil(ctx context.Context, interval time.Duration, timeout time.Duration, done func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		if !time.Now().Add(interval).Before(deadline) {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// durationOrDefault returns *d, or def if d is nil.
+func durationOrDefault(d *time.Duration, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+	return *d
+}
+
+// SafeRestartEngineOptions : The SafeRestartEngine options.
+type SafeRestartEngineOptions struct {
+	// Engine type.
+	EngineType *string `json:"engine_type" validate:"required"`
+
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Sources of outstanding work. The engine is restarted once all of them report zero.
+	DrainSources []AutoscalerMetricsSource `json:"-"`
+
+	// Maximum time to wait for the drain. Defaults to 10 minutes.
+	DrainTimeout *time.Duration `json:"drain_timeout,omitempty"`
+
+	// Maximum time to wait for the engine to leave the running state after the restart call. Defaults to 1 minute.
+	StopTimeout *time.Duration `json:"stop_timeout,omitempty"`
+
+	// Maximum time to wait for the engine to be running after the restart. Defaults to 10 minutes.
+	StartTimeout *time.Duration `json:"start_timeout,omitempty"`
+
+	// Time between checks while draining, stopping and starting. Defaults to 5 seconds.
+	PollInterval *time.Duration `json:"poll_interval,omitempty"`
+
+	// Query run to verify the engine after the restart. Defaults to "SELECT 1".
+	SmokeQuery *string `json:"smoke_query,omitempty"`
+
+	// Catalog of the smoke query.
+	SmokeQueryCatalog *string `json:"smoke_query_catalog,omitempty"`
+
+	// Schema of the smoke query.
+	SmokeQuerySchema *string `json:"smoke_query_schema,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// Constants associated with the SafeRestartEngineOptions.EngineType property.
+// Engine type.
+const (
+	SafeRestartEngineOptions_EngineType_Prestissimo = "prestissimo"
+	SafeRestartEngineOptions_EngineType_Presto      = "presto"
+)
+
+// NewSafeRestartEngineOptions : Instantiate SafeRestartEngineOptions
+func (*WatsonxDataV2) NewSafeRestartEngineOptions(engineType string, engineID string) *SafeRestartEngineOptions {
+	return &SafeRestartEngineOptions{
+		EngineType: core.StringPtr(engineType),
+		EngineID:   core.StringPtr(engineID),
+	}
+}
+
+// SetEngineType : Allow user to set EngineType
+func (_options *SafeRestartEngineOptions) SetEngineType(engineType string) *SafeRestartEngineOptions {
+	_options.EngineType = core.StringPtr(engineType)
+	return _options
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *SafeRestartEngineOptions) SetEngineID(engineID string) *SafeRestartEngineOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetDrainSources : Allow user to set DrainSources
+func (_options *SafeRestartEngineOptions) SetDrainSources(drainSources []AutoscalerMetricsSource) *SafeRestartEngineOptions {
+	_options.DrainSources = drainSources
+	return _options
+}
+
+// SetDrainTimeout : Allow user to set DrainTimeout
+func (_options *SafeRestartEngineOptions) SetDrainTimeout(drainTimeout time.Duration) *SafeRestartEngineOptions {
+	_options.DrainTimeout = &drainTimeout
+	return _options
+}
+
+// SetStopTimeout : Allow user to set StopTimeout
+func (_options *SafeRestartEngineOptions) SetStopTimeout(stopTimeout time.Duration) *SafeRestartEngineOptions {
+	_options.StopTimeout = &stopTimeout
+	return _options
+}
+
+// SetStartTimeout : Allow user to set StartTimeout
+func (_options *SafeRestartEngineOptions) SetStartTimeout(startTimeout time.Duration) *SafeRestartEngineOptions {
+	_options.StartTimeout = &startTimeout
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *SafeRestartEngineOptions) SetPollInterval(pollInterval time.Duration) *SafeRestartEngineOptions {
+	_options.PollInterval = &pollInterval
+	return _options
+}
+
+// SetSmokeQuery : Allow user to set SmokeQuery
+func (_options *SafeRestartEngineOptions) SetSmokeQuery(smokeQuery string) *SafeRestartEngineOptions {
+	_options.SmokeQuery = core.StringPtr(smokeQuery)
+	return _options
+}
+
+// SetSmokeQueryCatalog : Allow user to set SmokeQueryCatalog
+func (_options *SafeRestartEngineOptions) SetSmokeQueryCatalog(smokeQueryCatalog string) *SafeRestartEngineOptions {
+	_options.SmokeQueryCatalog = core.StringPtr(smokeQueryCatalog)
+	return _options
+}
+
+// SetSmokeQuerySchema : Allow user to set SmokeQuerySchema
+func (_options *SafeRestartEngineOptions) SetSmokeQuerySchema(smokeQuerySchema string) *SafeRestartEngineOptions {
+	_options.SmokeQuerySchema = core.StringPtr(smokeQuerySchema)
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *SafeRestartEngineOptions) SetAuthInstanceID(authInstanceID string) *SafeRestartEngineOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SafeRestartEngineOptions) SetHeaders(param map[string]string) *SafeRestartEngineOptions {
+	options.Headers = param
+	return options
+}
@@ -0,0 +1,226 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 safe restart`, func() {
+	var testServer *httptest.Server
+	var calls []string
+	var statusPolls int
+	var prestoStatuses []string
+	var cancelOnPoll func()
+	BeforeEach(func() {
+		calls = nil
+		statusPolls = 0
+		prestoStatuses = nil
+		cancelOnPoll = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			call := req.Method + " " + req.URL.EscapedPath()
+			calls = append(calls, call)
+			switch call {
+			case "POST /presto_engines/presto01/restart", "POST /prestissimo_engines/prestissimo01/restart":
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{}`)
+			case "GET /presto_engines/presto01":
+				statusPolls++
+				status := "starting"
+				if statusPolls > 2 {
+					status = "running"
+				}
+				if len(prestoStatuses) > 0 {
+					status, prestoStatuses = prestoStatuses[0], prestoStatuses[1:]
+				}
+				if cancelOnPoll != nil {
+					cancelOnPoll()
+				}
+				fmt.Fprintf(res, `{"engine_id": "presto01", "external_host_name": "h", "status_code": 200, "status": "%s"}`, status)
+			case "GET /prestissimo_engines/prestissimo01":
+				fmt.Fprint(res, `{"engine_id": "prestissimo01", "external_host_name": "h", "status_code": 200, "status": "starting"}`)
+			case "POST /queries/execute/presto01":
+				var body map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["sql_string"]).To(Equal("SELECT 1"))
+				Expect(body["catalog_name"]).To(Equal("system"))
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"response": {"result": [{"_col0": "1"}]}}`)
+			default:
+				Fail("unexpected request " + call)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`SafeRestartEngine(safeRestartEngineOptions *SafeRestartEngineOptions)`, func() {
+		It(`Drain, restart, wait for running and run the smoke query`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			result, operationErr := watsonxDataService.SafeRestartEngine(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			running := 3.0
+			queries := watsonxdatav2.AutoscalerMetricsSourceFunc(func(ctx context.Context) (float64, error) {
+				defer func() { running-- }()
+				return running, nil
+			})
+			safeRestartEngineOptionsModel := watsonxDataService.NewSafeRestartEngineOptions("presto", "presto01")
+			safeRestartEngineOptionsModel.SetDrainSources([]watsonxdatav2.AutoscalerMetricsSource{queries})
+			safeRestartEngineOptionsModel.SetPollInterval(time.Millisecond)
+			safeRestartEngineOptionsModel.SetSmokeQueryCatalog("system")
+			result, operationErr = watsonxDataService.SafeRestartEngine(safeRestartEngineOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.Restarted).To(BeTrue())
+			Expect(result.RestartUnconfirmed).To(BeFalse())
+			Expect(running).To(Equal(float64(-1)))
+			Expect(statusPolls).To(Equal(3))
+			Expect(calls[0]).To(Equal("POST /presto_engines/presto01/restart"))
+			Expect(calls[len(calls)-1]).To(Equal("POST /queries/execute/presto01"))
+			Expect(result.SmokeQueryResult.Response.Result).To(Equal([]map[string]string{{"_col0": "1"}}))
+		})
+		It(`Wait for the engine to go down before waiting for it to run`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			prestoStatuses = []string{"running", "running", "stopping", "starting", "running"}
+			safeRestartEngineOptionsModel := watsonxDataService.NewSafeRestartEngineOptions("presto", "presto01")
+			safeRestartEngineOptionsModel.SetPollInterval(time.Millisecond)
+			safeRestartEngineOptionsModel.SetSmokeQueryCatalog("system")
+			result, operationErr := watsonxDataService.SafeRestartEngine(safeRestartEngineOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.Restarted).To(BeTrue())
+			Expect(result.RestartUnconfirmed).To(BeFalse())
+			Expect(prestoStatuses).To(BeEmpty())
+			Expect(statusPolls).To(Equal(5))
+			Expect(calls[len(calls)-1]).To(Equal("POST /queries/execute/presto01"))
+		})
+		It(`Go on when the engine stays running through the stop timeout`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			prestoStatuses = []string{"running", "running", "running", "running", "running", "running"}
+			safeRestartEngineOptionsModel := watsonxDataService.NewSafeRestartEngineOptions("presto", "presto01")
+			safeRestartEngineOptionsModel.SetPollInterval(time.Millisecond)
+			safeRestartEngineOptionsModel.SetStopTimeout(3 * time.Millisecond)
+			safeRestartEngineOptionsModel.SetSmokeQueryCatalog("system")
+			result, operationErr := watsonxDataService.SafeRestartEngine(safeRestartEngineOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.StopWait).To(BeNumerically("<", time.Second))
+			Expect(result.RestartUnconfirmed).To(BeTrue())
+			Expect(calls[len(calls)-1]).To(Equal("POST /queries/execute/presto01"))
+		})
+		It(`Fail when the context is cancelled while the engine stops`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// The status poll succeeds and the context reports cancellation only afterwards.
+			ctx := &lateCancelContext{Context: context.Background()}
+			cancelOnPoll = func() { ctx.cancelled.Store(true) }
+			prestoStatuses = []string{"stopping"}
+			safeRestartEngineOptionsModel := watsonxDataService.NewSafeRestartEngineOptions("presto", "presto01")
+			safeRestartEngineOptionsModel.SetPollInterval(time.Millisecond)
+			result, operationErr := watsonxDataService.SafeRestartEngineWithContext(ctx, safeRestartEngineOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("did not stop after the restart: context canceled"))
+			Expect(result.Restarted).To(BeTrue())
+		})
+		It(`Do not restart when the drain times out`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			busy := watsonxdatav2.AutoscalerMetricsSourceFunc(func(ctx context.Context) (float64, error) {
+				return 1, nil
+			})
+			safeRestartEngineOptionsModel := watsonxDataService.NewSafeRestartEngineOptions("presto", "presto01")
+			safeRestartEngineOptionsModel.SetDrainSources([]watsonxdatav2.AutoscalerMetricsSource{busy})
+			safeRestartEngineOptionsModel.SetPollInterval(time.Millisecond)
+			safeRestartEngineOptionsModel.SetDrainTimeout(20 * time.Millisecond)
+			result, operationErr := watsonxDataService.SafeRestartEngine(safeRestartEngineOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("did not drain"))
+			Expect(result.Restarted).To(BeFalse())
+			Expect(calls).To(BeEmpty())
+		})
+		It(`Fail when the engine does not come back`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			safeRestartEngineOptionsModel := watsonxDataService.NewSafeRestartEngineOptions("prestissimo", "prestissimo01")
+			safeRestartEngineOptionsModel.SetPollInterval(time.Millisecond)
+			safeRestartEngineOptionsModel.SetStartTimeout(20 * time.Millisecond)
+			result, operationErr := watsonxDataService.SafeRestartEngine(safeRestartEngineOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("is not running after the restart"))
+			Expect(result.Restarted).To(BeTrue())
+			Expect(calls).ToNot(ContainElement("POST /queries/execute/prestissimo01"))
+
+			result, operationErr = watsonxDataService.SafeRestartEngine(watsonxDataService.NewSafeRestartEngineOptions("spark", "spark01"))
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+		})
+	})
+})
+
+// lateCancelContext is a context whose Err reports cancellation once cancelled is set, without closing Done.
+type lateCancelContext struct {
+	context.Context
+	cancelled atomic.Bool
+}
+
+func (ctx *lateCancelContext) Err() error {
+	if ctx.cancelled.Load() {
+		return context.Canceled
+	}
+	return nil
+}
@@ -0,0 +1,329 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// columnNameUnsafe matches the characters replaced when sanitizing a column name.
+var columnNameUnsafe = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// decimalType matches a decimal type with precision and scale.
+var decimalType = regexp.MustCompile(`^(?:decimal|numeric)\s*\(\s*(\d+)\s*,\s*(\d+)\s*\)$`)
+
+// Layouts of sample values recognised as dates and timestamps.
+var (
+	sampleDateLayouts      = []string{"2006-01-02"}
+	sampleTimestampLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999"}
+)
+
+// InferredColumn : A column of an inferred table schema.
+type InferredColumn struct {
+	// Sanitized column name.
+	Name string `json:"name"`
+
+	// Column name in the source file.
+	SourceName string `json:"source_name"`
+
+	// Column type reported by the preview.
+	SourceType string `json:"source_type"`
+
+	// Presto type of the column.
+	PrestoType string `json:"presto_type"`
+
+	// Iceberg type of the column.
+	IcebergType string `json:"iceberg_type"`
+}
+
+// InferredTableSchema : A proposed Iceberg table definition for an ingestion source.
+type InferredTableSchema struct {
+	// Columns in source order.
+	Columns []InferredColumn `json:"columns"`
+
+	// Suggested partition columns: date columns with few distinct values in the sample.
+	PartitionBy []string `json:"partition_by,omitempty"`
+}
+
+// InferTableSchema : Propose an Iceberg table definition from an ingestion file preview
+// Source types are mapped to Presto and Iceberg types; string types stay varchar. Columns with unknown types are typed
+// from the sample rows when every non-empty sample is an integer or number without leading zeros, a boolean, a date
+// or a timestamp. Column names are
+// lower-cased, characters other than letters, digits and underscores are replaced and duplicates get a numeric
+// suffix.
+func InferTableSchema(preview *PreviewIngestionFile) (schema *InferredTableSchema, err error) {
+	if preview == nil || len(preview.ColumnNames) == 0 {
+		err = core.SDKErrorf(nil, "the preview has no columns", "invalid-preview", common.GetComponentInfo())
+		return
+	}
+	if len(preview.ColumnTypes) != len(preview.ColumnNames) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the preview has %d column names but %d column types", len(preview.ColumnNames), len(preview.ColumnTypes)), "invalid-preview", common.GetComponentInfo())
+		return
+	}
+
+	rows := previewRows(preview.Rows)
+	schema = new(InferredTableSchema)
+	used := map[string]bool{}
+	for i, sourceName := range preview.ColumnNames {
+		var samples []string
+		for _, row := range rows {
+			if i < len(row) && strings.TrimSpace(row[i]) != "" {
+				samples = append(samples, strings.TrimSpace(row[i]))
+			}
+		}
+
+		column := InferredColumn{
+			Name:       uniqueColumnName(SanitizeColumnName(sourceName, i), used),
+			SourceName: sourceName,
+			SourceType: preview.ColumnTypes[i],
+		}
+		column.PrestoType, column.IcebergType = mapSourceType(preview.ColumnTypes[i], samples)
+		schema.Columns = append(schema.Columns, column)
+
+		if column.PrestoType == "date" && len(samples) > 1 && distinctCount(samples) <= len(samples)/2 {
+			schema.PartitionBy = append(schema.PartitionBy, column.Name)
+		}
+	}
+	return
+}
+
+// SanitizeColumnName : Turn a source column name into a safe lower-case identifier
+// Characters other than letters, digits and underscores become underscores, a leading digit gets a "c_" prefix and
+// an empty name becomes column_<position>, counting from 1.
+func SanitizeColumnName(name string, position int) string {
+	sanitized := strings.Trim(columnNameUnsafe.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "_"), "_")
+	if sanitized == "" {
+		return fmt.Sprintf("column_%d", position+1)
+	}
+	if sanitized[0] >= '0' && sanitized[0] <= '9' {
+		sanitized = "c_" + sanitized
+	}
+	return sanitized
+}
+
+// uniqueColumnName returns name, or name_<n> for the first n >= 2 not yet used, and marks the result used.
+func uniqueColumnName(name string, used map[string]bool) string {
+	unique := name
+	for n := 2; used[unique]; n++ {
+		unique = fmt.Sprintf("%s_%d", name, n)
+	}
+	used[unique] = true
+	return unique
+}
+
+// previewRows returns the preview rows in order, skipping missing ones.
+func previewRows(rows *PreviewIngestionFileRows) (result [][]string) {
+	if rows == nil {
+		return
+	}
+	for _, row := range [][]string{rows.RowOne, rows.RowTwo, rows.RowThree, rows.RowFour, rows.RowFive, rows.RowSix, rows.RowSeven, rows.RowEight, rows.RowNine, rows.RowTen} {
+		if row != nil {
+			result = append(result, row)
+		}
+	}
+	return
+}
+
+// distinctCount returns the number of distinct values.
+func distinctCount(values []string) int {
+	seen := map[string]bool{}
+	for _, value := range values {
+		seen[value] = true
+	}
+	return len(seen)
+}
+
+// mapSourceType returns the Presto and Iceberg types for a preview column type, using samples for unknown types.
+func mapSourceType(sourceType string, samples []string) (prestoType string, icebergType string) {
+	normalized := strings.ToLower(strings.TrimSpace(sourceType))
+	if match := decimalType.FindStringSubmatch(normalized); match != nil {
+		decimal := fmt.Sprintf("decimal(%s,%s)", match[1], match[2])
+		return decimal, decimal
+	}
+	switch {
+	case normalized == "int" || normalized == "integer" || normalized == "int32" || normalized == "int16" ||
+		normalized == "int8" || normalized == "smallint" || normalized == "tinyint" || normalized == "short":
+		return "integer", "int"
+	case normalized == "bigint" || normalized == "long" || normalized == "int64":
+		return "bigint", "long"
+	case normalized == "float" || normalized == "float32" || normalized == "real":
+		return "real", "float"
+	case normalized == "double" || normalized == "float64" || normalized == "number":
+		return "double", "double"
+	case normalized == "bool" || normalized == "boolean":
+		return "boolean", "boolean"
+	case normalized == "date" || normalized == "date32":
+		return "date", "date"
+	case strings.HasPrefix(normalized, "timestamp") || strings.HasPrefix(normalized, "datetime"):
+		return "timestamp", "timestamp"
+	case normalized == "binary" || normalized == "bytes" || normalized == "varbinary":
+		return "varbinary", "binary"
+	case normalized == "string" || normalized == "utf8" || normalized == "text" || normalized == "char" ||
+		strings.HasPrefix(normalized, "varchar") || strings.HasPrefix(normalized, "char("):
+		return "varchar", "string"
+	}
+	return inferSampleType(samples)
+}
+
+// inferSampleType returns the narrowest type that fits every sample, or varchar.
+func inferSampleType(samples []string) (prestoType string, icebergType string) {
+	if len(samples) == 0 {
+		return "varchar", "string"
+	}
+	all := func(fits func(string) bool) bool {
+		for _, sample := range samples {
+			if !fits(sample) {
+				return false
+			}
+		}
+		return true
+	}
+	parses := func(layouts []string) func(string) bool {
+		return func(sample string) bool {
+			for _, layout := range layouts {
+				if _, err := time.Parse(layout, sample); err == nil {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	// Numbers with leading zeros, such as zip codes or account IDs, would lose them.
+	switch {
+	case all(func(s string) bool { _, err := strconv.ParseInt(s, 10, 64); return err == nil && !hasLeadingZero(s) }):
+		return "bigint", "long"
+	case all(func(s string) bool { _, err := strconv.ParseFloat(s, 64); return err == nil && !hasLeadingZero(s) }):
+		return "double", "double"
+	case all(func(s string) bool { return strings.EqualFold(s, "true") || strings.EqualFold(s, "false") }):
+		return "boolean", "boolean"
+	case all(parses(sampleDateLayouts)):
+		return "date", "date"
+	case all(parses(sampleTimestampLayouts)):
+		return "timestamp", "timestamp"
+	}
+	return "varchar", "string"
+}
+
+// hasLeadingZero reports whether the integer part of a number has a zero before other digits, as in 00123 or -01.5.
+func hasLeadingZero(number string) bool {
+	digits := strings.TrimLeft(number, "+-")
+	return len(digits) > 1 && digits[0] == '0' && digits[1] >= '0' && digits[1] <= '9'
+}
+
+// DDL : Return a CREATE TABLE statement for CreateExecuteQuery
+// table is the target table name in format catalog.schema.table. The statement uses the Presto Iceberg connector
+// table properties, with the suggested partitioning if there is any.
+func (schema *InferredTableSchema) DDL(table string) (ddl string, err error) {
+	parts := strings.Split(table, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("table name '%s' is not in format catalog.schema.table", table), "invalid-table-name", common.GetComponentInfo())
+		return
+	}
+	quote := func(identifier string) string {
+		return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "CREATE TABLE %s.%s.%s (\n", quote(parts[0]), quote(parts[1]), quote(parts[2]))
+	for i, column := range schema.Columns {
+		separator := ","
+		if i == len(schema.Columns)-1 {
+			separator = ""
+		}
+		fmt.Fprintf(&builder, "  %s %s%s\n", quote(column.Name), column.PrestoType, separator)
+	}
+	builder.WriteString(")\nWITH (\n  format = 'PARQUET'")
+	if len(schema.PartitionBy) > 0 {
+		partitions := make([]string, len(schema.PartitionBy))
+		for i, name := range schema.PartitionBy {
+			partitions[i] = "'" + name + "'"
+		}
+		fmt.Fprintf(&builder, ",\n  partitioning = ARRAY[%s]", strings.Join(partitions, ", "))
+	}
+	builder.WriteString("\n)")
+	ddl = builder.String()
+	return
+}
+
+// IcebergSchema : Return the schema as an Iceberg struct in JSON, the format of CreateIngestionJobsOptions.Schema.
+// Columns are optional because the sample cannot prove that a column is never empty.
+func (schema *InferredTableSchema) IcebergSchema() (string, error) {
+	type field struct {
+		ID       int    `json:"id"`
+		Name     string `json:"name"`
+		Required bool   `json:"required"`
+		Type     string `json:"type"`
+	}
+	fields := make([]field, len(schema.Columns))
+	for i, column := range schema.Columns {
+		fields[i] = field{
+			ID:   i + 1,
+			Name: column.Name,
+			Type: column.IcebergType,
+		}
+	}
+	encoded, err := json.Marshal(struct {
+		Type     string  `json:"type"`
+		SchemaID int     `json:"schema-id"`
+		Fields   []field `json:"fields"`
+	}{"struct", 0, fields})
+	if err != nil {
+		return "", core.SDKErrorf(err, "", "schema-json-error", common.GetComponentInfo())
+	}
+	return string(encoded), nil
+}
+
+// ApplyTo : Set Schema, and PartitionBy if it is not already set, on ingestion job options.
+func (schema *InferredTableSchema) ApplyTo(createIngestionJobsOptions *CreateIngestionJobsOptions) error {
+	icebergSchema, err := schema.IcebergSchema()
+	if err != nil {
+		return err
+	}
+	createIngestionJobsOptions.Schema = core.StringPtr(icebergSchema)
+	if createIngestionJobsOptions.PartitionBy == nil && len(schema.PartitionBy) > 0 {
+		createIngestionJobsOptions.PartitionBy = core.StringPtr(strings.Join(schema.PartitionBy, ", "))
+	}
+	return nil
+}
+
+// InferIngestionFileSchema : Preview ingestion source files and propose an Iceberg table definition
+// See CreatePreviewIngestionFile and InferTableSchema.
+func (watsonxData *WatsonxDataV2) InferIngestionFileSchema(createPreviewIngestionFileOptions *CreatePreviewIngestionFileOptions) (result *InferredTableSchema, err error) {
+	result, err = watsonxData.InferIngestionFileSchemaWithContext(context.Background(), createPreviewIngestionFileOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// InferIngestionFileSchemaWithContext is an alternate form of the InferIngestionFileSchema method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) InferIngestionFileSchemaWithContext(ctx context.Context, createPreviewIngestionFileOptions *CreatePreviewIngestionFileOptions) (result *InferredTableSchema, err error) {
+	preview, _, err := watsonxData.CreatePreviewIngestionFileWithContext(ctx, createPreviewIngestionFileOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	result, err = InferTableSchema(preview)
+	return
+}
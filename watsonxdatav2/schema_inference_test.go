@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 schema inference`, func() {
+	var testServer *httptest.Server
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.URL.EscapedPath()).To(Equal("/preview_ingestion_file"))
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{
+				"file_name": "orders.csv",
+				"column_names": ["Order ID", "Amount", "order date", "Shipped", "Created At", "1st note", "Amount", ""],
+				"column_types": ["", "decimal(10, 2)", "", "", "", "string", "double", "int"],
+				"rows": {
+					"row_one": ["1", "10.50", "2025-06-01", "true", "2025-06-01 10:00:00", "x", "1.5", "1"],
+					"row_two": ["2", "7.25", "2025-06-01", "FALSE", "2025-06-01T11:00:00Z", "", "2", "2"],
+					"row_three": ["3", "1.00", "2025-06-02", "true", "2025-06-02 09:30:00.123", "12", "", "3"],
+					"row_four": ["4", "2.00", "2025-06-02", "false", "2025-06-02 09:45:00", "z", "3", "4"]
+				}
+			}`)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`InferIngestionFileSchema(createPreviewIngestionFileOptions *CreatePreviewIngestionFileOptions)`, func() {
+		It(`Infer a table schema from a preview`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			schema, operationErr := watsonxDataService.InferIngestionFileSchema(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(schema).To(BeNil())
+
+			schema, operationErr = watsonxDataService.InferIngestionFileSchema(watsonxDataService.NewCreatePreviewIngestionFileOptions("crn:1", "s3://bucket/orders.csv"))
+			Expect(operationErr).To(BeNil())
+			Expect(schema.Columns).To(HaveLen(8))
+			Expect(schema.Columns[0]).To(Equal(watsonxdatav2.InferredColumn{
+				Name:        "order_id",
+				SourceName:  "Order ID",
+				SourceType:  "",
+				PrestoType:  "bigint",
+				IcebergType: "long",
+			}))
+			names := []string{}
+			types := []string{}
+			for _, column := range schema.Columns {
+				names = append(names, column.Name)
+				types = append(types, column.PrestoType)
+			}
+			Expect(names).To(Equal([]string{"order_id", "amount", "order_date", "shipped", "created_at", "c_1st_note", "amount_2", "column_8"}))
+			Expect(types).To(Equal([]string{"bigint", "decimal(10,2)", "date", "boolean", "timestamp", "varchar", "double", "integer"}))
+			Expect(schema.PartitionBy).To(Equal([]string{"order_date"}))
+
+			ddl, err := schema.DDL("iceberg_data.sales.orders")
+			Expect(err).To(BeNil())
+			Expect(ddl).To(Equal("CREATE TABLE \"iceberg_data\".\"sales\".\"orders\" (\n" +
+				"  \"order_id\" bigint,\n" +
+				"  \"amount\" decimal(10,2),\n" +
+				"  \"order_date\" date,\n" +
+				"  \"shipped\" boolean,\n" +
+				"  \"created_at\" timestamp,\n" +
+				"  \"c_1st_note\" varchar,\n" +
+				"  \"amount_2\" double,\n" +
+				"  \"column_8\" integer\n" +
+				")\nWITH (\n  format = 'PARQUET',\n  partitioning = ARRAY['order_date']\n)"))
+			_, err = schema.DDL("orders")
+			Expect(err).ToNot(BeNil())
+
+			createIngestionJobsOptionsModel := watsonxDataService.NewCreateIngestionJobsOptions("crn:1", "job1", "s3://bucket/orders.csv", "iceberg_data.sales.orders", "ibmlhadmin")
+			Expect(schema.ApplyTo(createIngestionJobsOptionsModel)).To(Succeed())
+			Expect(*createIngestionJobsOptionsModel.PartitionBy).To(Equal("order_date"))
+			Expect(*createIngestionJobsOptionsModel.Schema).To(HavePrefix(`{"type":"struct","schema-id":0,"fields":[{"id":1,"name":"order_id","required":false,"type":"long"},`))
+			Expect(*createIngestionJobsOptionsModel.Schema).To(HaveSuffix(`{"id":8,"name":"column_8","required":false,"type":"int"}]}`))
+		})
+		It(`Keep string columns and numbers with leading zeros as varchar`, func() {
+			schema, err := watsonxdatav2.InferTableSchema(&watsonxdatav2.PreviewIngestionFile{
+				ColumnNames: []string{"zip", "account", "quantity", "code", "ratio"},
+				ColumnTypes: []string{"", "", "", "varchar(10)", ""},
+				Rows: &watsonxdatav2.PreviewIngestionFileRows{
+					RowOne: []string{"00123", "-0042", "0", "7", "0.5"},
+					RowTwo: []string{"10001", "17", "12", "8", "01.5"},
+				},
+			})
+			Expect(err).To(BeNil())
+			types := []string{}
+			for _, column := range schema.Columns {
+				types = append(types, column.PrestoType)
+			}
+			Expect(types).To(Equal([]string{"varchar", "varchar", "bigint", "varchar", "varchar"}))
+		})
+		It(`Reject an inconsistent preview`, func() {
+			_, err := watsonxdatav2.InferTableSchema(&watsonxdatav2.PreviewIngestionFile{
+				ColumnNames: []string{"a", "b"},
+				ColumnTypes: []string{"int"},
+			})
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxdatav2.InferTableSchema(nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
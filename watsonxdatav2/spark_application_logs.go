@@ -0,0 +1,720 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// defaultSparkLogPollInterval is the time between reads when following a log.
+const defaultSparkLogPollInterval = 5 * time.Second
+
+// SparkApplicationLog : A log of a Spark application.
+type SparkApplicationLog struct {
+	// Name of the log, unique within the application.
+	Name string `json:"name"`
+
+	// Kind of log.
+	Kind string `json:"kind"`
+
+	// Where the source reads the log from.
+	Location string `json:"location"`
+
+	// Size in bytes, or -1 if unknown.
+	Size int64 `json:"size"`
+
+	// The log is an archive. It is read whole and cannot be followed.
+	Archive bool `json:"archive,omitempty"`
+}
+
+// Constants associated with the SparkApplicationLog.Kind property.
+// Kind of log.
+const (
+	SparkApplicationLog_Kind_Driver   = "driver"
+	SparkApplicationLog_Kind_Event    = "event"
+	SparkApplicationLog_Kind_Executor = "executor"
+)
+
+// SparkApplicationLogSource : Lists and reads the logs of Spark applications.
+type SparkApplicationLogSource interface {
+	// ListLogs returns the logs of the application.
+	ListLogs(ctx context.Context, application *SparkEngineApplicationStatus) ([]SparkApplicationLog, error)
+
+	// OpenLog returns the content of the log starting at offset.
+	OpenLog(ctx context.Context, log SparkApplicationLog, offset int64) (io.ReadCloser, error)
+}
+
+// SparkHistoryServerLogSource : Reads Spark application logs through the Spark history server REST API.
+// Driver and executor logs come from the executor list and the event log is the zip archive served for the
+// application.
+type SparkHistoryServerLogSource struct {
+	// History server endpoint.
+	Endpoint string
+
+	service *WatsonxDataV2
+}
+
+// NewSparkHistoryServerLogSource : Instantiate SparkHistoryServerLogSource
+// Requests to the endpoint host are authenticated with the service authenticator.
+func (watsonxData *WatsonxDataV2) NewSparkHistoryServerLogSource(endpoint string) *SparkHistoryServerLogSource {
+	return &SparkHistoryServerLogSource{
+		Endpoint: strings.TrimSuffix(endpoint, "/"),
+		service:  watsonxData,
+	}
+}
+
+// sparkHistoryServerExecutor is an entry of the history server executor list.
+type sparkHistoryServerExecutor struct {
+	ID           string            `json:"id"`
+	ExecutorLogs map[string]string `json:"executorLogs"`
+}
+
+// ListLogs : List the driver, executor and event logs of the application.
+func (source *SparkHistoryServerLogSource) ListLogs(ctx context.Context, application *SparkEngineApplicationStatus) (logs []SparkApplicationLog, err error) {
+	sparkApplicationID := core.StringNilMapper(application.SparkApplicationID)
+	if sparkApplicationID == "" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("application %s has no Spark application ID yet", core.StringNilMapper(application.ApplicationID)), "missing-spark-application-id", common.GetComponentInfo())
+		return
+	}
+	pathParamsMap := map[string]string{
+		"application_id": sparkApplicationID,
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	if _, err = builder.ResolveRequestURL(source.Endpoint, `/api/v1/applications/{application_id}/allexecutors`, pathParamsMap); err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+	builder.AddHeader("Accept", "application/json")
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+	var executors []sparkHistoryServerExecutor
+	_, err = source.service.Service.Request(request, &executors)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		return
+	}
+
+	for _, executor := range executors {
+		kind := SparkApplicationLog_Kind_Executor
+		prefix := "executor-" + executor.ID
+		if executor.ID == "driver" {
+			kind = SparkApplicationLog_Kind_Driver
+			prefix = "driver"
+		}
+		for stream, location := range executor.ExecutorLogs {
+			logs = append(logs, SparkApplicationLog{
+				Name:     prefix + "/" + stream,
+				Kind:     kind,
+				Location: location,
+				Size:     -1,
+			})
+		}
+	}
+	logs = append(logs, SparkApplicationLog{
+		Name:     "eventlog",
+		Kind:     SparkApplicationLog_Kind_Event,
+		Location: source.Endpoint + "/api/v1/applications/" + url.PathEscape(sparkApplicationID) + "/logs",
+		Size:     -1,
+		Archive:  true,
+	})
+	sortSparkApplicationLogs(logs)
+	return
+}
+
+// OpenLog : Read a log from the history server
+// Only logs on the history server host are read with the service credentials; others are read without them. A
+// Range header asks for the content after offset; if the server ignores it, the first offset bytes are skipped. A
+// 416 response means the log has nothing after offset yet, so an empty body is returned.
+func (source *SparkHistoryServerLogSource) OpenLog(ctx context.Context, log SparkApplicationLog, offset int64) (body io.ReadCloser, err error) {
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	if _, err = builder.ResolveRequestURL(log.Location, "", nil); err != nil {
+		err = core.SDKErrorf(err, "", "url-resolve-error", common.GetComponentInfo())
+		return
+	}
+	if offset > 0 {
+		builder.AddHeader("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	request, err := builder.Build()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "build-error", common.GetComponentInfo())
+		return
+	}
+	var response *core.DetailedResponse
+	if source.authenticates(request.URL) {
+		response, err = source.service.Service.Request(request, &body)
+		if err != nil {
+			if offset > 0 && response != nil && response.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+				return http.NoBody, nil
+			}
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
+	} else {
+		// Executor log URLs come from the history server and may name any host, so they get no credentials.
+		var httpResponse *http.Response
+		httpResponse, err = source.service.Service.Client.Do(request)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+			return
+		}
+		if offset > 0 && httpResponse.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			httpResponse.Body.Close()
+			return http.NoBody, nil
+		}
+		if httpResponse.StatusCode < 200 || httpResponse.StatusCode >= 300 {
+			httpResponse.Body.Close()
+			err = core.SDKErrorf(nil, fmt.Sprintf("reading log %s: %s", log.Name, httpResponse.Status), "http-request-err", common.GetComponentInfo())
+			return
+		}
+		response = &core.DetailedResponse{StatusCode: httpResponse.StatusCode}
+		body = httpResponse.Body
+	}
+	if offset > 0 && response.StatusCode != http.StatusPartialContent {
+		if _, err = io.CopyN(io.Discard, body, offset); err != nil && err != io.EOF {
+			body.Close()
+			return nil, core.SDKErrorf(err, "", "log-read-error", common.GetComponentInfo())
+		}
+		err = nil
+	}
+	return
+}
+
+// authenticates returns whether requests to location are sent with the service credentials, which is only the
+// case for the scheme and host of the history server endpoint.
+func (source *SparkHistoryServerLogSource) authenticates(location *url.URL) bool {
+	endpoint, err := url.Parse(source.Endpoint)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(endpoint.Scheme, location.Scheme) && strings.EqualFold(endpoint.Host, location.Host)
+}
+
+// SparkLogObject : An object in a SparkLogStore.
+type SparkLogObject struct {
+	// Object key, with / separators.
+	Key string
+
+	// Size in bytes.
+	Size int64
+}
+
+// SparkLogStore : Access to the bucket that holds the engine home path.
+// Implement it with the object storage client of your choice; NewLocalSparkLogStore serves a local directory, such
+// as a mounted or downloaded copy of the bucket.
+type SparkLogStore interface {
+	// List returns the objects whose keys start with prefix.
+	List(ctx context.Context, prefix string) ([]SparkLogObject, error)
+
+	// Open returns the content of an object starting at offset.
+	Open(ctx context.Context, key string, offset int64) (io.ReadCloser, error)
+}
+
+// localSparkLogStore is a SparkLogStore over a local directory.
+type localSparkLogStore struct {
+	root string
+}
+
+// NewLocalSparkLogStore : Instantiate a SparkLogStore that reads the directory root.
+func NewLocalSparkLogStore(root string) SparkLogStore {
+	return &localSparkLogStore{
+		root: root,
+	}
+}
+
+// List returns the regular files under root whose slash-separated relative path starts with prefix.
+func (store *localSparkLogStore) List(ctx context.Context, prefix string) (objects []SparkLogObject, err error) {
+	dir := filepath.Join(store.root, filepath.FromSlash(path.Dir(prefix+"x")))
+	err = filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(store.root, file)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			objects = append(objects, SparkLogObject{
+				Key:  key,
+				Size: info.Size(),
+			})
+		}
+		return nil
+	})
+	return
+}
+
+// Open opens the file for key and seeks to offset.
+func (store *localSparkLogStore) Open(ctx context.Context, key string, offset int64) (io.ReadCloser, error) {
+	file, err := os.Open(filepath.Join(store.root, filepath.FromSlash(key)))
+	if err != nil {
+		return nil, err
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+// SparkEngineHomeLogSource : Reads Spark application logs from the engine home path.
+// Driver and executor logs are read from <HomePath>/logs/<application ID>/ and event logs from
+// <HomePath>/spark-events/<Spark application ID>, with an optional attempt ID (<Spark application ID>_<attempt ID>)
+// and extension such as .inprogress. Rolled event logs are read from the
+// <HomePath>/spark-events/eventlog_v2_<Spark application ID>/ directory. Event logs are named by their path under
+// spark-events/. The kind of a log under logs/ is taken from its name: names containing "driver" are driver logs and
+// the others executor logs.
+type SparkEngineHomeLogSource struct {
+	// Bucket access.
+	Store SparkLogStore
+
+	// Engine home path within the bucket, for example SparkEngineDetails.EngineHomePath.
+	HomePath string
+}
+
+// NewSparkEngineHomeLogSource : Instantiate SparkEngineHomeLogSource
+func NewSparkEngineHomeLogSource(store SparkLogStore, homePath string) *SparkEngineHomeLogSource {
+	return &SparkEngineHomeLogSource{
+		Store:    store,
+		HomePath: strings.Trim(homePath, "/"),
+	}
+}
+
+// ListLogs : List the logs of the application found in the store.
+func (source *SparkEngineHomeLogSource) ListLogs(ctx context.Context, application *SparkEngineApplicationStatus) (logs []SparkApplicationLog, err error) {
+	applicationID := core.StringNilMapper(application.ApplicationID)
+	if applicationID == "" {
+		applicationID = core.StringNilMapper(application.ID)
+	}
+	if applicationID == "" {
+		err = core.SDKErrorf(nil, "the application has no ID", "missing-application-id", common.GetComponentInfo())
+		return
+	}
+	logPrefix := path.Join(source.HomePath, "logs", applicationID) + "/"
+	objects, err := source.Store.List(ctx, logPrefix)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "log-list-error", common.GetComponentInfo())
+		return
+	}
+	for _, object := range objects {
+		name := strings.TrimPrefix(object.Key, logPrefix)
+		kind := SparkApplicationLog_Kind_Executor
+		if strings.Contains(strings.ToLower(name), "driver") {
+			kind = SparkApplicationLog_Kind_Driver
+		}
+		logs = append(logs, SparkApplicationLog{
+			Name:     name,
+			Kind:     kind,
+			Location: object.Key,
+			Size:     object.Size,
+		})
+	}
+
+	if sparkApplicationID := core.StringNilMapper(application.SparkApplicationID); sparkApplicationID != "" {
+		eventDirectory := path.Join(source.HomePath, "spark-events") + "/"
+		// Single event logs are named after the application and rolled ones are kept in eventlog_v2_<ID>/.
+		for _, eventPrefix := range []string{eventDirectory + sparkApplicationID, eventDirectory + "eventlog_v2_" + sparkApplicationID} {
+			objects, err = source.Store.List(ctx, eventPrefix)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "log-list-error", common.GetComponentInfo())
+				return
+			}
+			for _, object := range objects {
+				// The prefix also matches other applications whose ID starts with this one. Attempts are separated
+				// by an underscore, as in <ID>_<attempt ID>.
+				if rest := strings.TrimPrefix(object.Key, eventPrefix); rest != "" && !strings.ContainsRune("/._", rune(rest[0])) {
+					continue
+				}
+				logs = append(logs, SparkApplicationLog{
+					Name:     strings.TrimPrefix(object.Key, eventDirectory),
+					Kind:     SparkApplicationLog_Kind_Event,
+					Location: object.Key,
+					Size:     object.Size,
+				})
+			}
+		}
+	}
+	sortSparkApplicationLogs(logs)
+	return
+}
+
+// OpenLog : Read a log from the store.
+func (source *SparkEngineHomeLogSource) OpenLog(ctx context.Context, log SparkApplicationLog, offset int64) (body io.ReadCloser, err error) {
+	body, err = source.Store.Open(ctx, log.Location, offset)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "log-read-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// sortSparkApplicationLogs orders logs by kind (driver, executor, event) and then by name.
+func sortSparkApplicationLogs(logs []SparkApplicationLog) {
+	rank := map[string]int{
+		SparkApplicationLog_Kind_Driver:   0,
+		SparkApplicationLog_Kind_Executor: 1,
+		SparkApplicationLog_Kind_Event:    2,
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].Kind != logs[j].Kind {
+			return rank[logs[i].Kind] < rank[logs[j].Kind]
+		}
+		return logs[i].Name < logs[j].Name
+	})
+}
+
+// sparkApplicationLogSource returns source, or a history server source for the engine if source is nil.
+func (watsonxData *WatsonxDataV2) sparkApplicationLogSource(ctx context.Context, source SparkApplicationLogSource, engineID *string, authInstanceID *string, headers map[string]string) (SparkApplicationLogSource, error) {
+	if source != nil {
+		return source, nil
+	}
+	engine, _, err := watsonxData.GetSparkEngineWithContext(ctx, &GetSparkEngineOptions{
+		EngineID:       engineID,
+		AuthInstanceID: authInstanceID,
+		Headers:        headers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var endpoint string
+	if engine.EngineDetails != nil && engine.EngineDetails.Endpoints != nil {
+		endpoint = core.StringNilMapper(engine.EngineDetails.Endpoints.WxdHistoryServerEndpoint)
+		if endpoint == "" {
+			endpoint = core.StringNilMapper(engine.EngineDetails.Endpoints.HistoryServerEndpoint)
+		}
+	}
+	if endpoint == "" {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("engine %s has no history server endpoint", *engineID), "missing-history-server-endpoint", common.GetComponentInfo())
+	}
+	return watsonxData.NewSparkHistoryServerLogSource(endpoint), nil
+}
+
+// ListSparkEngineApplicationLogs : List the logs of a Spark application
+// Logs are read from Source, or from the history server of the engine if no source is set. Start the history server
+// with StartSparkEngineHistoryServer before using it.
+func (watsonxData *WatsonxDataV2) ListSparkEngineApplicationLogs(listSparkEngineApplicationLogsOptions *ListSparkEngineApplicationLogsOptions) (result []SparkApplicationLog, err error) {
+	result, err = watsonxData.ListSparkEngineApplicationLogsWithContext(context.Background(), listSparkEngineApplicationLogsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ListSparkEngineApplicationLogsWithContext is an alternate form of the ListSparkEngineApplicationLogs method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) ListSparkEngineApplicationLogsWithContext(ctx context.Context, listSparkEngineApplicationLogsOptions *ListSparkEngineApplicationLogsOptions) (result []SparkApplicationLog, err error) {
+	err = core.ValidateNotNil(listSparkEngineApplicationLogsOptions, "listSparkEngineApplicationLogsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(listSparkEngineApplicationLogsOptions, "listSparkEngineApplicationLogsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := listSparkEngineApplicationLogsOptions
+	application, _, err := watsonxData.GetSparkEngineApplicationStatusWithContext(ctx, &GetSparkEngineApplicationStatusOptions{
+		EngineID:       opts.EngineID,
+		ApplicationID:  opts.ApplicationID,
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	source, err := watsonxData.sparkApplicationLogSource(ctx, opts.Source, opts.EngineID, opts.AuthInstanceID, opts.Headers)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	result, err = source.ListLogs(ctx, application)
+	return
+}
+
+// ReadSparkEngineApplicationLog : Copy a Spark application log to Output
+// With Follow set, the log is read again every PollInterval from where the last read ended until the application
+// reaches a terminal state, like tail -f. Archives, such as the history server event log, are read once. Returns the
+// number of bytes written.
+func (watsonxData *WatsonxDataV2) ReadSparkEngineApplicationLog(readSparkEngineApplicationLogOptions *ReadSparkEngineApplicationLogOptions) (written int64, err error) {
+	written, err = watsonxData.ReadSparkEngineApplicationLogWithContext(context.Background(), readSparkEngineApplicationLogOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReadSparkEngineApplicationLogWithContext is an alternate form of the ReadSparkEngineApplicationLog method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) ReadSparkEngineApplicationLogWithContext(ctx context.Context, readSparkEngineApplicationLogOptions *ReadSparkEngineApplicationLogOptions) (written int64, err error) {
+	err = core.ValidateNotNil(readSparkEngineApplicationLogOptions, "readSparkEngineApplicationLogOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(readSparkEngineApplicationLogOptions, "readSparkEngineApplicationLogOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := readSparkEngineApplicationLogOptions
+	statusOptions := &GetSparkEngineApplicationStatusOptions{
+		EngineID:       opts.EngineID,
+		ApplicationID:  opts.ApplicationID,
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	}
+	application, _, err := watsonxData.GetSparkEngineApplicationStatusWithContext(ctx, statusOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	source, err := watsonxData.sparkApplicationLogSource(ctx, opts.Source, opts.EngineID, opts.AuthInstanceID, opts.Headers)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+
+	follow := opts.Follow != nil && *opts.Follow
+	pollInterval := durationOrDefault(opts.PollInterval, defaultSparkLogPollInterval)
+	var log *SparkApplicationLog
+	for {
+		// Check the state before reading so the last read happens after the application ended.
+		done := !follow || sparkApplicationTerminalStates[strings.ToLower(core.StringNilMapper(application.State))]
+		if log == nil {
+			var logs []SparkApplicationLog
+			logs, err = source.ListLogs(ctx, application)
+			if err != nil {
+				return
+			}
+			for i := range logs {
+				if logs[i].Name == *opts.LogName {
+					log = &logs[i]
+					break
+				}
+			}
+		}
+		if log != nil && log.Archive {
+			done = true
+		}
+		if log != nil {
+			var body io.ReadCloser
+			body, err = source.OpenLog(ctx, *log, written)
+			if err != nil {
+				return
+			}
+			var n int64
+			n, err = io.Copy(opts.Output, body)
+			body.Close()
+			written += n
+			if err != nil {
+				err = core.SDKErrorf(err, "", "log-write-error", common.GetComponentInfo())
+				return
+			}
+		}
+		if done {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		case <-time.After(pollInterval):
+		}
+		application, _, err = watsonxData.GetSparkEngineApplicationStatusWithContext(ctx, statusOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "")
+			return
+		}
+	}
+	if log == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("application %s has no log '%s'", *opts.ApplicationID, *opts.LogName), "log-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+// ListSparkEngineApplicationLogsOptions : The ListSparkEngineApplicationLogs options.
+type ListSparkEngineApplicationLogsOptions struct {
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Application id.
+	ApplicationID *string `json:"application_id" validate:"required,ne="`
+
+	// Where to read the logs. Defaults to the history server of the engine.
+	Source SparkApplicationLogSource `json:"-"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewListSparkEngineApplicationLogsOptions : Instantiate ListSparkEngineApplicationLogsOptions
+func (*WatsonxDataV2) NewListSparkEngineApplicationLogsOptions(engineID string, applicationID string) *ListSparkEngineApplicationLogsOptions {
+	return &ListSparkEngineApplicationLogsOptions{
+		EngineID:      core.StringPtr(engineID),
+		ApplicationID: core.StringPtr(applicationID),
+	}
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *ListSparkEngineApplicationLogsOptions) SetEngineID(engineID string) *ListSparkEngineApplicationLogsOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetApplicationID : Allow user to set ApplicationID
+func (_options *ListSparkEngineApplicationLogsOptions) SetApplicationID(applicationID string) *ListSparkEngineApplicationLogsOptions {
+	_options.ApplicationID = core.StringPtr(applicationID)
+	return _options
+}
+
+// SetSource : Allow user to set Source
+func (_options *ListSparkEngineApplicationLogsOptions) SetSource(source SparkApplicationLogSource) *ListSparkEngineApplicationLogsOptions {
+	_options.Source = source
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *ListSparkEngineApplicationLogsOptions) SetAuthInstanceID(authInstanceID string) *ListSparkEngineApplicationLogsOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ListSparkEngineApplicationLogsOptions) SetHeaders(param map[string]string) *ListSparkEngineApplicationLogsOptions {
+	options.Headers = param
+	return options
+}
+
+// ReadSparkEngineApplicationLogOptions : The ReadSparkEngineApplicationLog options.
+type ReadSparkEngineApplicationLogOptions struct {
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Application id.
+	ApplicationID *string `json:"application_id" validate:"required,ne="`
+
+	// Name of the log, as returned by ListSparkEngineApplicationLogs.
+	LogName *string `json:"log_name" validate:"required,ne="`
+
+	// Destination of the log content.
+	Output io.Writer `json:"-" validate:"required"`
+
+	// Where to read the log. Defaults to the history server of the engine.
+	Source SparkApplicationLogSource `json:"-"`
+
+	// Keep reading until the application reaches a terminal state.
+	Follow *bool `json:"follow,omitempty"`
+
+	// Time between reads when following. Defaults to 5 seconds.
+	PollInterval *time.Duration `json:"poll_interval,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewReadSparkEngineApplicationLogOptions : Instantiate ReadSparkEngineApplicationLogOptions
+func (*WatsonxDataV2) NewReadSparkEngineApplicationLogOptions(engineID string, applicationID string, logName string, output io.Writer) *ReadSparkEngineApplicationLogOptions {
+	return &ReadSparkEngineApplicationLogOptions{
+		EngineID:      core.StringPtr(engineID),
+		ApplicationID: core.StringPtr(applicationID),
+		LogName:       core.StringPtr(logName),
+		Output:        output,
+	}
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *ReadSparkEngineApplicationLogOptions) SetEngineID(engineID string) *ReadSparkEngineApplicationLogOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetApplicationID : Allow user to set ApplicationID
+func (_options *ReadSparkEngineApplicationLogOptions) SetApplicationID(applicationID string) *ReadSparkEngineApplicationLogOptions {
+	_options.ApplicationID = core.StringPtr(applicationID)
+	return _options
+}
+
+// SetLogName : Allow user to set LogName
+func (_options *ReadSparkEngineApplicationLogOptions) SetLogName(logName string) *ReadSparkEngineApplicationLogOptions {
+	_options.LogName = core.StringPtr(logName)
+	return _options
+}
+
+// SetOutput : Allow user to set Output
+func (_options *ReadSparkEngineApplicationLogOptions) SetOutput(output io.Writer) *ReadSparkEngineApplicationLogOptions {
+	_options.Output = output
+	return _options
+}
+
+// SetSource : Allow user to set Source
+func (_options *ReadSparkEngineApplicationLogOptions) SetSource(source SparkApplicationLogSource) *ReadSparkEngineApplicationLogOptions {
+	_options.Source = source
+	return _options
+}
+
+// SetFollow : Allow user to set Follow
+func (_options *ReadSparkEngineApplicationLogOptions) SetFollow(follow bool) *ReadSparkEngineApplicationLogOptions {
+	_options.Follow = core.BoolPtr(follow)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *ReadSparkEngineApplicationLogOptions) SetPollInterval(pollInterval time.Duration) *ReadSparkEngineApplicationLogOptions {
+	_options.PollInterval = &pollInterval
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *ReadSparkEngineApplicationLogOptions) SetAuthInstanceID(authInstanceID string) *ReadSparkEngineApplicationLogOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ReadSparkEngineApplicationLogOptions) SetHeaders(param map[string]string) *ReadSparkEngineApplicationLogOptions {
+	options.Headers = param
+	return options
+}
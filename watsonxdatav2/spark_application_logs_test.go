@@ -0,0 +1,314 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 Spark application logs`, func() {
+	var testServer *httptest.Server
+	var statusCalls int
+	var driverLog string
+	BeforeEach(func() {
+		statusCalls = 0
+		driverLog = ""
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/spark_engines/spark01":
+				fmt.Fprintf(res, `{"engine_id": "spark01", "engine_details": {"endpoints": {"wxd_history_server_endpoint": "%s/history/"}}}`, testServer.URL)
+			case "/spark_engines/spark01/applications/app01":
+				// Each status check sees two more lines of driver output, and the application finishes on the third.
+				statusCalls++
+				driverLog += fmt.Sprintf("line %d\nline %d\n", 2*statusCalls-1, 2*statusCalls)
+				state := "running"
+				if statusCalls >= 3 {
+					state = "finished"
+				}
+				fmt.Fprintf(res, `{"application_id": "app01", "spark_application_id": "spark-123", "state": "%s"}`, state)
+			case "/history/api/v1/applications/spark-123/allexecutors":
+				fmt.Fprintf(res, `[{"id": "driver", "executorLogs": {"stdout": "%[1]s/history/logs/driver/stdout"}}, {"id": "1", "executorLogs": {"stderr": "%[1]s/history/logs/1/stderr"}}]`, testServer.URL)
+			case "/history/api/v1/applications/spark-123/logs":
+				Expect(req.Header.Get("Range")).To(BeEmpty())
+				res.Header().Set("Content-type", "application/zip")
+				fmt.Fprint(res, "PK archive")
+			case "/history/logs/driver/stdout":
+				res.Header().Set("Content-type", "text/plain")
+				offset := 0
+				if rangeHeader := req.Header.Get("Range"); rangeHeader != "" {
+					offset, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rangeHeader, "bytes="), "-"))
+					res.WriteHeader(http.StatusPartialContent)
+				}
+				fmt.Fprint(res, driverLog[offset:])
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`ListSparkEngineApplicationLogs(listSparkEngineApplicationLogsOptions *ListSparkEngineApplicationLogsOptions)`, func() {
+		It(`List logs from the history server`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			logs, operationErr := watsonxDataService.ListSparkEngineApplicationLogs(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(logs).To(BeNil())
+
+			logs, operationErr = watsonxDataService.ListSparkEngineApplicationLogs(watsonxDataService.NewListSparkEngineApplicationLogsOptions("spark01", "app01"))
+			Expect(operationErr).To(BeNil())
+			Expect(logs).To(Equal([]watsonxdatav2.SparkApplicationLog{
+				{Name: "driver/stdout", Kind: "driver", Location: testServer.URL + "/history/logs/driver/stdout", Size: -1},
+				{Name: "executor-1/stderr", Kind: "executor", Location: testServer.URL + "/history/logs/1/stderr", Size: -1},
+				{Name: "eventlog", Kind: "event", Location: testServer.URL + "/history/api/v1/applications/spark-123/logs", Size: -1, Archive: true},
+			}))
+		})
+		It(`List logs from the engine home path`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			root, err := os.MkdirTemp("", "spark-home")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(root)
+			for name, content := range map[string]string{
+				"spark/home/logs/app01/spark-driver.log":                             "driver output\n",
+				"spark/home/logs/app01/executor-1/stderr":                            "executor output\n",
+				"spark/home/logs/app012/spark-driver.log":                            "another application\n",
+				"spark/home/spark-events/spark-123.inprogress":                       "{}\n",
+				"spark/home/spark-events/spark-1234":                                 "{}\n",
+				"spark/home/spark-events/spark-123_1":                                "{}\n",
+				"spark/home/spark-events/spark-123-2":                                "{}\n",
+				"spark/home/spark-events/eventlog_v2_spark-123/events_1_spark-123":   "{}\n",
+				"spark/home/spark-events/eventlog_v2_spark-123/appstatus_spark-123":  "",
+				"spark/home/spark-events/eventlog_v2_spark-1234/events_1_spark-1234": "{}\n",
+			} {
+				file := filepath.Join(root, filepath.FromSlash(name))
+				Expect(os.MkdirAll(filepath.Dir(file), 0700)).To(Succeed())
+				Expect(os.WriteFile(file, []byte(content), 0600)).To(Succeed())
+			}
+			source := watsonxdatav2.NewSparkEngineHomeLogSource(watsonxdatav2.NewLocalSparkLogStore(root), "/spark/home/")
+
+			listSparkEngineApplicationLogsOptionsModel := watsonxDataService.NewListSparkEngineApplicationLogsOptions("spark01", "app01")
+			listSparkEngineApplicationLogsOptionsModel.SetSource(source)
+			logs, operationErr := watsonxDataService.ListSparkEngineApplicationLogs(listSparkEngineApplicationLogsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(logs).To(Equal([]watsonxdatav2.SparkApplicationLog{
+				{Name: "spark-driver.log", Kind: "driver", Location: "spark/home/logs/app01/spark-driver.log", Size: 14},
+				{Name: "executor-1/stderr", Kind: "executor", Location: "spark/home/logs/app01/executor-1/stderr", Size: 16},
+				{Name: "eventlog_v2_spark-123/appstatus_spark-123", Kind: "event", Location: "spark/home/spark-events/eventlog_v2_spark-123/appstatus_spark-123", Size: 0},
+				{Name: "eventlog_v2_spark-123/events_1_spark-123", Kind: "event", Location: "spark/home/spark-events/eventlog_v2_spark-123/events_1_spark-123", Size: 3},
+				{Name: "spark-123.inprogress", Kind: "event", Location: "spark/home/spark-events/spark-123.inprogress", Size: 3},
+				{Name: "spark-123_1", Kind: "event", Location: "spark/home/spark-events/spark-123_1", Size: 3},
+			}))
+
+			output := new(bytes.Buffer)
+			readSparkEngineApplicationLogOptionsModel := watsonxDataService.NewReadSparkEngineApplicationLogOptions("spark01", "app01", "executor-1/stderr", output)
+			readSparkEngineApplicationLogOptionsModel.SetSource(source)
+			written, operationErr := watsonxDataService.ReadSparkEngineApplicationLog(readSparkEngineApplicationLogOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(written).To(Equal(int64(16)))
+			Expect(output.String()).To(Equal("executor output\n"))
+
+			_, err = source.ListLogs(context.Background(), &watsonxdatav2.SparkEngineApplicationStatus{})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("the application has no ID"))
+		})
+	})
+	Describe(`ReadSparkEngineApplicationLog(readSparkEngineApplicationLogOptions *ReadSparkEngineApplicationLogOptions)`, func() {
+		It(`Read executor logs on other hosts without credentials`, func() {
+			var foreignAuthorization []string
+			foreignServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				foreignAuthorization = append(foreignAuthorization, req.Header.Get("Authorization"))
+				res.Header().Set("Content-type", "text/plain")
+				fmt.Fprint(res, "executor output\n")
+			}))
+			defer foreignServer.Close()
+			var historyAuthorization []string
+			historyServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.EscapedPath() {
+				case "/spark_engines/spark01/applications/app01":
+					fmt.Fprint(res, `{"application_id": "app01", "spark_application_id": "spark-123", "state": "finished"}`)
+				case "/history/api/v1/applications/spark-123/allexecutors":
+					historyAuthorization = append(historyAuthorization, req.Header.Get("Authorization"))
+					fmt.Fprintf(res, `[{"id": "2", "executorLogs": {"stdout": "%s/node/logs/2/stdout"}}]`, foreignServer.URL)
+				default:
+					Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+				}
+			}))
+			defer historyServer.Close()
+
+			authenticator, err := core.NewBearerTokenAuthenticator("secret-token")
+			Expect(err).To(BeNil())
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           historyServer.URL,
+				Authenticator: authenticator,
+			})
+			Expect(serviceErr).To(BeNil())
+
+			output := new(bytes.Buffer)
+			readSparkEngineApplicationLogOptionsModel := watsonxDataService.NewReadSparkEngineApplicationLogOptions("spark01", "app01", "executor-2/stdout", output)
+			readSparkEngineApplicationLogOptionsModel.SetSource(watsonxDataService.NewSparkHistoryServerLogSource(historyServer.URL + "/history"))
+			_, operationErr := watsonxDataService.ReadSparkEngineApplicationLog(readSparkEngineApplicationLogOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(output.String()).To(Equal("executor output\n"))
+			Expect(historyAuthorization).To(Equal([]string{"Bearer secret-token"}))
+			Expect(foreignAuthorization).To(Equal([]string{""}))
+		})
+		It(`Follow a log until the application finishes`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			written, operationErr := watsonxDataService.ReadSparkEngineApplicationLog(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(written).To(BeZero())
+
+			output := new(bytes.Buffer)
+			readSparkEngineApplicationLogOptionsModel := watsonxDataService.NewReadSparkEngineApplicationLogOptions("spark01", "app01", "driver/stdout", output)
+			readSparkEngineApplicationLogOptionsModel.SetFollow(true)
+			readSparkEngineApplicationLogOptionsModel.SetPollInterval(time.Millisecond)
+			written, operationErr = watsonxDataService.ReadSparkEngineApplicationLog(readSparkEngineApplicationLogOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(statusCalls).To(Equal(3))
+			Expect(output.String()).To(Equal("line 1\nline 2\nline 3\nline 4\nline 5\nline 6\n"))
+			Expect(written).To(Equal(int64(output.Len())))
+
+			readSparkEngineApplicationLogOptionsModel.SetLogName("driver/stderr")
+			_, operationErr = watsonxDataService.ReadSparkEngineApplicationLogWithContext(context.Background(), readSparkEngineApplicationLogOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(operationErr.Error()).To(ContainSubstring("has no log 'driver/stderr'"))
+		})
+		It(`Follow logs through polls without new output`, func() {
+			// The logs gain a line on the first and third status checks only; reads past the end get a 416.
+			var statusChecks int
+			logContent := func() string {
+				if statusChecks >= 3 {
+					return "line 1\nline 2\n"
+				}
+				return "line 1\n"
+			}
+			serveLog := func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "text/plain")
+				content := logContent()
+				offset := 0
+				if rangeHeader := req.Header.Get("Range"); rangeHeader != "" {
+					offset, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rangeHeader, "bytes="), "-"))
+					if offset >= len(content) {
+						res.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+						return
+					}
+					res.WriteHeader(http.StatusPartialContent)
+				}
+				fmt.Fprint(res, content[offset:])
+			}
+			foreignServer := httptest.NewServer(http.HandlerFunc(serveLog))
+			defer foreignServer.Close()
+			historyServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				switch req.URL.EscapedPath() {
+				case "/spark_engines/spark01/applications/app01":
+					statusChecks++
+					state := "running"
+					if statusChecks >= 4 {
+						state = "finished"
+					}
+					fmt.Fprintf(res, `{"application_id": "app01", "spark_application_id": "spark-123", "state": "%s"}`, state)
+				case "/history/api/v1/applications/spark-123/allexecutors":
+					fmt.Fprintf(res, `[{"id": "driver", "executorLogs": {"stdout": "http://%s/history/logs/driver/stdout"}}, {"id": "2", "executorLogs": {"stdout": "%s/node/logs/2/stdout"}}]`, req.Host, foreignServer.URL)
+				case "/history/logs/driver/stdout":
+					serveLog(res, req)
+				default:
+					Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+				}
+			}))
+			defer historyServer.Close()
+
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           historyServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// driver/stdout is read with the service client and executor-2/stdout without it.
+			for _, logName := range []string{"driver/stdout", "executor-2/stdout"} {
+				statusChecks = 0
+				output := new(bytes.Buffer)
+				readSparkEngineApplicationLogOptionsModel := watsonxDataService.NewReadSparkEngineApplicationLogOptions("spark01", "app01", logName, output)
+				readSparkEngineApplicationLogOptionsModel.SetSource(watsonxDataService.NewSparkHistoryServerLogSource(historyServer.URL + "/history"))
+				readSparkEngineApplicationLogOptionsModel.SetFollow(true)
+				readSparkEngineApplicationLogOptionsModel.SetPollInterval(time.Millisecond)
+				written, operationErr := watsonxDataService.ReadSparkEngineApplicationLog(readSparkEngineApplicationLogOptionsModel)
+				Expect(operationErr).To(BeNil())
+				Expect(statusChecks).To(Equal(4))
+				Expect(output.String()).To(Equal("line 1\nline 2\n"))
+				Expect(written).To(Equal(int64(14)))
+			}
+		})
+		It(`Read the event log archive once when following`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			output := new(bytes.Buffer)
+			readSparkEngineApplicationLogOptionsModel := watsonxDataService.NewReadSparkEngineApplicationLogOptions("spark01", "app01", "eventlog", output)
+			readSparkEngineApplicationLogOptionsModel.SetFollow(true)
+			readSparkEngineApplicationLogOptionsModel.SetPollInterval(time.Millisecond)
+			_, operationErr := watsonxDataService.ReadSparkEngineApplicationLog(readSparkEngineApplicationLogOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(statusCalls).To(Equal(1))
+			Expect(output.String()).To(Equal("PK archive"))
+		})
+	})
+})
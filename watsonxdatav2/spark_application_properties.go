@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"encoding/json"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// sparkApplicationPropertyEntries holds the entries set on SparkApplicationConfig and SparkApplicationEnv values,
+// keyed by their address. The models only have a field for a sample key, so any other entry is kept here. Keys are
+// addresses rather than pointers so that the values can be garbage collected; a finalizer removes their entries.
+var (
+	sparkApplicationPropertyEntries      = map[uintptr]map[string]string{}
+	sparkApplicationPropertyEntriesMutex sync.Mutex
+)
+
+// setSparkApplicationProperty stores an entry of owner, a *SparkApplicationConfig or *SparkApplicationEnv.
+func setSparkApplicationProperty(owner interface{}, key string, value string) {
+	address := reflect.ValueOf(owner).Pointer()
+	sparkApplicationPropertyEntriesMutex.Lock()
+	defer sparkApplicationPropertyEntriesMutex.Unlock()
+	entries, ok := sparkApplicationPropertyEntries[address]
+	if !ok {
+		entries = map[string]string{}
+		sparkApplicationPropertyEntries[address] = entries
+		runtime.SetFinalizer(owner, func(interface{}) {
+			sparkApplicationPropertyEntriesMutex.Lock()
+			defer sparkApplicationPropertyEntriesMutex.Unlock()
+			delete(sparkApplicationPropertyEntries, address)
+		})
+	}
+	entries[key] = value
+}
+
+// clearSparkApplicationProperties removes the entries stored for owner. Its finalizer stays in place.
+func clearSparkApplicationProperties(owner interface{}) {
+	address := reflect.ValueOf(owner).Pointer()
+	sparkApplicationPropertyEntriesMutex.Lock()
+	defer sparkApplicationPropertyEntriesMutex.Unlock()
+	if _, ok := sparkApplicationPropertyEntries[address]; ok {
+		sparkApplicationPropertyEntries[address] = map[string]string{}
+	}
+}
+
+// sparkApplicationProperties returns a copy of the entries stored for owner.
+func sparkApplicationProperties(owner interface{}) map[string]string {
+	address := reflect.ValueOf(owner).Pointer()
+	sparkApplicationPropertyEntriesMutex.Lock()
+	defer sparkApplicationPropertyEntriesMutex.Unlock()
+	properties := make(map[string]string, len(sparkApplicationPropertyEntries[address]))
+	for key, value := range sparkApplicationPropertyEntries[address] {
+		properties[key] = value
+	}
+	return properties
+}
+
+// SetProperty : Allow user to set any Spark property
+// The entries are kept with the value they are set on, not with copies of it, so o must be allocated on its own,
+// as with new or a composite literal, and not be a field of another struct.
+func (o *SparkApplicationConfig) SetProperty(key string, value string) {
+	if key == "spark_sample_config_properpty" {
+		o.SparkSampleConfigProperpty = &value
+		return
+	}
+	setSparkApplicationProperty(o, key, value)
+}
+
+// SetProperties : Allow user to set several Spark properties
+func (o *SparkApplicationConfig) SetProperties(properties map[string]string) {
+	for key, value := range properties {
+		o.SetProperty(key, value)
+	}
+}
+
+// GetProperty : Return a Spark property, or "" if it is not set
+func (o *SparkApplicationConfig) GetProperty(key string) string {
+	return o.GetProperties()[key]
+}
+
+// GetProperties : Return every Spark property, including SparkSampleConfigProperpty
+func (o *SparkApplicationConfig) GetProperties() map[string]string {
+	properties := sparkApplicationProperties(o)
+	if o.SparkSampleConfigProperpty != nil {
+		properties["spark_sample_config_properpty"] = *o.SparkSampleConfigProperpty
+	}
+	return properties
+}
+
+// MarshalJSON performs custom serialization for instances of SparkApplicationConfig
+func (o *SparkApplicationConfig) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.GetProperties())
+}
+
+// UnmarshalJSON performs custom deserialization for instances of SparkApplicationConfig
+// Every entry is kept. Values that are not strings keep their JSON text, so 200 becomes "200".
+func (o *SparkApplicationConfig) UnmarshalJSON(data []byte) error {
+	properties, err := unmarshalSparkApplicationProperties(data)
+	if err != nil {
+		return err
+	}
+	*o = SparkApplicationConfig{}
+	clearSparkApplicationProperties(o)
+	o.SetProperties(properties)
+	return nil
+}
+
+// SetProperty : Allow user to set any environment variable
+// The entries are kept with the value they are set on, not with copies of it, so o must be allocated on its own,
+// as with new or a composite literal, and not be a field of another struct.
+func (o *SparkApplicationEnv) SetProperty(key string, value string) {
+	if key == "sample_env_key" {
+		o.SampleEnvKey = &value
+		return
+	}
+	setSparkApplicationProperty(o, key, value)
+}
+
+// SetProperties : Allow user to set several environment variables
+func (o *SparkApplicationEnv) SetProperties(properties map[string]string) {
+	for key, value := range properties {
+		o.SetProperty(key, value)
+	}
+}
+
+// GetProperty : Return an environment variable, or "" if it is not set
+func (o *SparkApplicationEnv) GetProperty(key string) string {
+	return o.GetProperties()[key]
+}
+
+// GetProperties : Return every environment variable, including SampleEnvKey
+func (o *SparkApplicationEnv) GetProperties() map[string]string {
+	properties := sparkApplicationProperties(o)
+	if o.SampleEnvKey != nil {
+		properties["sample_env_key"] = *o.SampleEnvKey
+	}
+	return properties
+}
+
+// MarshalJSON performs custom serialization for instances of SparkApplicationEnv
+func (o *SparkApplicationEnv) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.GetProperties())
+}
+
+// UnmarshalJSON performs custom deserialization for instances of SparkApplicationEnv
+// Every entry is kept. Values that are not strings keep their JSON text.
+func (o *SparkApplicationEnv) UnmarshalJSON(data []byte) error {
+	properties, err := unmarshalSparkApplicationProperties(data)
+	if err != nil {
+		return err
+	}
+	*o = SparkApplicationEnv{}
+	clearSparkApplicationProperties(o)
+	o.SetProperties(properties)
+	return nil
+}
+
+// unmarshalSparkApplicationProperties reads a JSON object of conf or env entries. Values that are not strings keep
+// their JSON text.
+func unmarshalSparkApplicationProperties(data []byte) (properties map[string]string, err error) {
+	var values map[string]json.RawMessage
+	if err = json.Unmarshal(data, &values); err != nil {
+		return
+	}
+	properties = make(map[string]string, len(values))
+	for key, value := range values {
+		var text string
+		if json.Unmarshal(value, &text) != nil {
+			text = string(value)
+		}
+		properties[key] = text
+	}
+	return
+}
@@ -0,0 +1,349 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// sparkApplicationRecordColumns are the CSV columns written by SparkApplicationRecords.WriteCSV.
+var sparkApplicationRecordColumns = []string{
+	"application_id",
+	"spark_application_id",
+	"name",
+	"state",
+	"return_code",
+	"submission_time",
+	"start_time",
+	"end_time",
+	"duration_seconds",
+}
+
+// SparkApplicationRecord : A flattened Spark application status with parsed times.
+type SparkApplicationRecord struct {
+	// Application ID.
+	ApplicationID string `json:"application_id"`
+
+	// Spark application ID.
+	SparkApplicationID string `json:"spark_application_id,omitempty"`
+
+	// Application name.
+	Name string `json:"name,omitempty"`
+
+	// Application state.
+	State string `json:"state,omitempty"`
+
+	// Return code.
+	ReturnCode string `json:"return_code,omitempty"`
+
+	// Submission time.
+	SubmissionTime *time.Time `json:"submission_time,omitempty"`
+
+	// Start time.
+	StartTime *time.Time `json:"start_time,omitempty"`
+
+	// End time: the end, finish or failed time.
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// Run time in seconds, if the application has started and ended.
+	DurationSeconds *float64 `json:"duration_seconds,omitempty"`
+}
+
+// NewSparkApplicationRecord : Instantiate SparkApplicationRecord from an application status
+// The name is the Spark application name, or the name in the application details. Times that cannot be parsed are
+// left unset.
+func NewSparkApplicationRecord(status *SparkEngineApplicationStatus) *SparkApplicationRecord {
+	record := &SparkApplicationRecord{
+		ApplicationID:      core.StringNilMapper(status.ApplicationID),
+		SparkApplicationID: core.StringNilMapper(status.SparkApplicationID),
+		Name:               core.StringNilMapper(status.SparkApplicationName),
+		State:              core.StringNilMapper(status.State),
+		ReturnCode:         core.StringNilMapper(status.ReturnCode),
+		SubmissionTime:     sparkTimePtr(status.SubmissionTime),
+		StartTime:          sparkTimePtr(status.StartTime),
+	}
+	if record.ApplicationID == "" {
+		record.ApplicationID = core.StringNilMapper(status.ID)
+	}
+	if record.Name == "" && status.ApplicationDetails != nil {
+		record.Name = core.StringNilMapper(status.ApplicationDetails.Name)
+	}
+	for _, end := range []*string{status.EndTime, status.FinishTime, status.FailedTime} {
+		if record.EndTime = sparkTimePtr(end); record.EndTime != nil {
+			break
+		}
+	}
+	if record.StartTime != nil && record.EndTime != nil {
+		seconds := record.EndTime.Sub(*record.StartTime).Seconds()
+		record.DurationSeconds = &seconds
+	}
+	return record
+}
+
+// Duration : Return the run time of the application. ok is false if it has not both started and ended.
+func (record *SparkApplicationRecord) Duration() (duration time.Duration, ok bool) {
+	if record.DurationSeconds == nil {
+		return
+	}
+	return time.Duration(*record.DurationSeconds * float64(time.Second)), true
+}
+
+// sparkTimePtr parses a Spark time string into UTC, returning nil if it is missing or unparseable.
+func sparkTimePtr(value *string) *time.Time {
+	if value == nil {
+		return nil
+	}
+	t, ok := parseSparkTime(*value)
+	if !ok {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
+
+// SparkApplicationRecords : A list of Spark application records.
+type SparkApplicationRecords []SparkApplicationRecord
+
+// WriteJSON writes the records to writer as a JSON array.
+func (records SparkApplicationRecords) WriteJSON(writer io.Writer) error {
+	return writeRecordsJSON(writer, records)
+}
+
+// WriteCSV writes the records to writer as CSV with a header row. Times are in RFC 3339 format.
+func (records SparkApplicationRecords) WriteCSV(writer io.Writer) error {
+	return writeRecordsCSV(writer, sparkApplicationRecordColumns, len(records), func(i int) []string {
+		record := &records[i]
+		return []string{
+			record.ApplicationID,
+			record.SparkApplicationID,
+			record.Name,
+			record.State,
+			record.ReturnCode,
+			formatRecordTime(record.SubmissionTime),
+			formatRecordTime(record.StartTime),
+			formatRecordTime(record.EndTime),
+			formatRecordSeconds(record.DurationSeconds),
+		}
+	})
+}
+
+// QuerySparkEngineApplications : Find Spark applications matching a filter
+// The State filter is passed to ListSparkEngineApplications; the other filters are applied to the returned statuses.
+// Every filter that is set must match. Results are ordered by start time, newest first, with applications that have
+// not started at the end.
+func (watsonxData *WatsonxDataV2) QuerySparkEngineApplications(querySparkEngineApplicationsOptions *QuerySparkEngineApplicationsOptions) (result SparkApplicationRecords, err error) {
+	result, err = watsonxData.QuerySparkEngineApplicationsWithContext(context.Background(), querySparkEngineApplicationsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// QuerySparkEngineApplicationsWithContext is an alternate form of the QuerySparkEngineApplications method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) QuerySparkEngineApplicationsWithContext(ctx context.Context, querySparkEngineApplicationsOptions *QuerySparkEngineApplicationsOptions) (result SparkApplicationRecords, err error) {
+	err = core.ValidateNotNil(querySparkEngineApplicationsOptions, "querySparkEngineApplicationsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(querySparkEngineApplicationsOptions, "querySparkEngineApplicationsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := querySparkEngineApplicationsOptions
+	if opts.Name != nil {
+		if _, err = path.Match(*opts.Name, ""); err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("invalid name pattern '%s'", *opts.Name), "invalid-name-pattern", common.GetComponentInfo())
+			return
+		}
+	}
+
+	collection, _, err := watsonxData.ListSparkEngineApplicationsWithContext(ctx, &ListSparkEngineApplicationsOptions{
+		EngineID:       opts.EngineID,
+		State:          opts.State,
+		AuthInstanceID: opts.AuthInstanceID,
+		Headers:        opts.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+
+	result = SparkApplicationRecords{}
+	for i := range collection.Applications {
+		record := NewSparkApplicationRecord(&collection.Applications[i])
+		if opts.matches(record) {
+			result = append(result, *record)
+		}
+	}
+	sortRecordsNewestFirst(result, func(i int) *time.Time {
+		return result[i].StartTime
+	})
+	return
+}
+
+// matches reports whether the record passes every filter that is set.
+func (opts *QuerySparkEngineApplicationsOptions) matches(record *SparkApplicationRecord) bool {
+	if opts.Name != nil {
+		if ok, _ := path.Match(*opts.Name, record.Name); !ok {
+			return false
+		}
+	}
+	if len(opts.State) > 0 && !containsFold(opts.State, record.State) {
+		return false
+	}
+	if len(opts.ReturnCode) > 0 && !containsFold(opts.ReturnCode, record.ReturnCode) {
+		return false
+	}
+	if opts.StartedAfter != nil || opts.StartedBefore != nil {
+		started := record.StartTime
+		if started == nil {
+			started = record.SubmissionTime
+		}
+		if started == nil ||
+			(opts.StartedAfter != nil && started.Before(*opts.StartedAfter)) ||
+			(opts.StartedBefore != nil && !started.Before(*opts.StartedBefore)) {
+			return false
+		}
+	}
+	if opts.MinDuration != nil || opts.MaxDuration != nil {
+		duration, ok := record.Duration()
+		if !ok ||
+			(opts.MinDuration != nil && duration < *opts.MinDuration) ||
+			(opts.MaxDuration != nil && duration > *opts.MaxDuration) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsFold reports whether values contains value, ignoring case.
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// QuerySparkEngineApplicationsOptions : The QuerySparkEngineApplications options.
+type QuerySparkEngineApplicationsOptions struct {
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Glob pattern, as in path.Match, for the application name.
+	Name *string `json:"name,omitempty"`
+
+	// Application states, matched without regard to case.
+	State []string `json:"state,omitempty"`
+
+	// Return codes.
+	ReturnCode []string `json:"return_code,omitempty"`
+
+	// Only applications started at or after this time. The submission time is used if there is no start time.
+	StartedAfter *time.Time `json:"started_after,omitempty"`
+
+	// Only applications started before this time. The submission time is used if there is no start time.
+	StartedBefore *time.Time `json:"started_before,omitempty"`
+
+	// Only applications that ran at least this long.
+	MinDuration *time.Duration `json:"min_duration,omitempty"`
+
+	// Only applications that ran at most this long.
+	MaxDuration *time.Duration `json:"max_duration,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewQuerySparkEngineApplicationsOptions : Instantiate QuerySparkEngineApplicationsOptions
+func (*WatsonxDataV2) NewQuerySparkEngineApplicationsOptions(engineID string) *QuerySparkEngineApplicationsOptions {
+	return &QuerySparkEngineApplicationsOptions{
+		EngineID: core.StringPtr(engineID),
+	}
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *QuerySparkEngineApplicationsOptions) SetEngineID(engineID string) *QuerySparkEngineApplicationsOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetName : Allow user to set Name
+func (_options *QuerySparkEngineApplicationsOptions) SetName(name string) *QuerySparkEngineApplicationsOptions {
+	_options.Name = core.StringPtr(name)
+	return _options
+}
+
+// SetState : Allow user to set State
+func (_options *QuerySparkEngineApplicationsOptions) SetState(state []string) *QuerySparkEngineApplicationsOptions {
+	_options.State = state
+	return _options
+}
+
+// SetReturnCode : Allow user to set ReturnCode
+func (_options *QuerySparkEngineApplicationsOptions) SetReturnCode(returnCode []string) *QuerySparkEngineApplicationsOptions {
+	_options.ReturnCode = returnCode
+	return _options
+}
+
+// SetStartedAfter : Allow user to set StartedAfter
+func (_options *QuerySparkEngineApplicationsOptions) SetStartedAfter(startedAfter time.Time) *QuerySparkEngineApplicationsOptions {
+	_options.StartedAfter = &startedAfter
+	return _options
+}
+
+// SetStartedBefore : Allow user to set StartedBefore
+func (_options *QuerySparkEngineApplicationsOptions) SetStartedBefore(startedBefore time.Time) *QuerySparkEngineApplicationsOptions {
+	_options.StartedBefore = &startedBefore
+	return _options
+}
+
+// SetMinDuration : Allow user to set MinDuration
+func (_options *QuerySparkEngineApplicationsOptions) SetMinDuration(minDuration time.Duration) *QuerySparkEngineApplicationsOptions {
+	_options.MinDuration = &minDuration
+	return _options
+}
+
+// SetMaxDuration : Allow user to set MaxDuration
+func (_options *QuerySparkEngineApplicationsOptions) SetMaxDuration(maxDuration time.Duration) *QuerySparkEngineApplicationsOptions {
+	_options.MaxDuration = &maxDuration
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *QuerySparkEngineApplicationsOptions) SetAuthInstanceID(authInstanceID string) *QuerySparkEngineApplicationsOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *QuerySparkEngineApplicationsOptions) SetHeaders(param map[string]string) *QuerySparkEngineApplicationsOptions {
+	options.Headers = param
+	return options
+}
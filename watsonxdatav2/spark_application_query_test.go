@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 Spark application query`, func() {
+	var testServer *httptest.Server
+	var stateQuery string
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/spark_engines/spark01/applications"))
+			stateQuery = req.URL.Query().Get("state")
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"applications": [
+				{"application_id": "a1", "spark_application_name": "rollup-sales", "state": "FINISHED", "return_code": "0",
+				 "start_time": "2025-06-06T10:00:00.000Z", "end_time": "2025-06-06T10:05:00.000Z"},
+				{"application_id": "a2", "application_details": {"name": "rollup-orders"}, "state": "FAILED", "return_code": "1",
+				 "start_time": "Saturday 7 June 2025 10:00:00.000+0000", "failed_time": "Saturday 7 June 2025 10:00:30.000+0000"},
+				{"id": "a3", "spark_application_name": "export, \"daily\"", "state": "FINISHED", "return_code": "0",
+				 "start_time": "2025-06-08T10:00:00Z", "finish_time": "2025-06-08T11:00:00Z"},
+				{"application_id": "a4", "spark_application_name": "rollup-pending", "state": "ACCEPTED", "submission_time": "2025-06-09T09:00:00Z"}
+			]}`)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	Describe(`QuerySparkEngineApplications(querySparkEngineApplicationsOptions *QuerySparkEngineApplicationsOptions)`, func() {
+		It(`Filter applications`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			records, operationErr := watsonxDataService.QuerySparkEngineApplications(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(records).To(BeNil())
+
+			records, operationErr = watsonxDataService.QuerySparkEngineApplications(watsonxDataService.NewQuerySparkEngineApplicationsOptions("spark01"))
+			Expect(operationErr).To(BeNil())
+			ids := func() (ids []string) {
+				for _, record := range records {
+					ids = append(ids, record.ApplicationID)
+				}
+				return
+			}
+			Expect(ids()).To(Equal([]string{"a3", "a2", "a1", "a4"}))
+			Expect(records[1].Name).To(Equal("rollup-orders"))
+			duration, ok := records[1].Duration()
+			Expect(ok).To(BeTrue())
+			Expect(duration).To(Equal(30 * time.Second))
+
+			querySparkEngineApplicationsOptionsModel := watsonxDataService.NewQuerySparkEngineApplicationsOptions("spark01")
+			querySparkEngineApplicationsOptionsModel.SetName("rollup-*")
+			querySparkEngineApplicationsOptionsModel.SetState([]string{"finished", "failed"})
+			records, operationErr = watsonxDataService.QuerySparkEngineApplications(querySparkEngineApplicationsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(stateQuery).To(Equal("finished,failed"))
+			Expect(ids()).To(Equal([]string{"a2", "a1"}))
+
+			querySparkEngineApplicationsOptionsModel = watsonxDataService.NewQuerySparkEngineApplicationsOptions("spark01")
+			querySparkEngineApplicationsOptionsModel.SetReturnCode([]string{"0"})
+			querySparkEngineApplicationsOptionsModel.SetMinDuration(10 * time.Minute)
+			records, operationErr = watsonxDataService.QuerySparkEngineApplications(querySparkEngineApplicationsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(ids()).To(Equal([]string{"a3"}))
+
+			querySparkEngineApplicationsOptionsModel = watsonxDataService.NewQuerySparkEngineApplicationsOptions("spark01")
+			querySparkEngineApplicationsOptionsModel.SetStartedAfter(time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC))
+			querySparkEngineApplicationsOptionsModel.SetStartedBefore(time.Date(2025, 6, 9, 9, 0, 0, 0, time.UTC))
+			querySparkEngineApplicationsOptionsModel.SetMaxDuration(time.Hour)
+			records, operationErr = watsonxDataService.QuerySparkEngineApplications(querySparkEngineApplicationsOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(ids()).To(Equal([]string{"a3", "a2"}))
+
+			querySparkEngineApplicationsOptionsModel.SetName("[")
+			_, operationErr = watsonxDataService.QuerySparkEngineApplications(querySparkEngineApplicationsOptionsModel)
+			Expect(operationErr).ToNot(BeNil())
+		})
+		It(`Export records as CSV and JSON`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			querySparkEngineApplicationsOptionsModel := watsonxDataService.NewQuerySparkEngineApplicationsOptions("spark01")
+			querySparkEngineApplicationsOptionsModel.SetReturnCode([]string{"0"})
+			records, operationErr := watsonxDataService.QuerySparkEngineApplications(querySparkEngineApplicationsOptionsModel)
+			Expect(operationErr).To(BeNil())
+
+			output := new(bytes.Buffer)
+			Expect(records.WriteCSV(output)).To(Succeed())
+			Expect(output.String()).To(Equal(
+				"application_id,spark_application_id,name,state,return_code,submission_time,start_time,end_time,duration_seconds\n" +
+					"a3,,\"export, \"\"daily\"\"\",FINISHED,0,,2025-06-08T10:00:00Z,2025-06-08T11:00:00Z,3600\n" +
+					"a1,,rollup-sales,FINISHED,0,,2025-06-06T10:00:00Z,2025-06-06T10:05:00Z,300\n"))
+
+			output.Reset()
+			Expect(records.WriteJSON(output)).To(Succeed())
+			var exported []map[string]interface{}
+			Expect(json.Unmarshal(output.Bytes(), &exported)).To(Succeed())
+			Expect(exported).To(HaveLen(2))
+			Expect(exported[1]).To(Equal(map[string]interface{}{
+				"application_id":   "a1",
+				"name":             "rollup-sales",
+				"state":            "FINISHED",
+				"return_code":      "0",
+				"start_time":       "2025-06-06T10:00:00Z",
+				"end_time":         "2025-06-06T10:05:00Z",
+				"duration_seconds": float64(300),
+			}))
+
+			output.Reset()
+			Expect(watsonxdatav2.SparkApplicationRecords(nil).WriteJSON(output)).To(Succeed())
+			Expect(output.String()).To(Equal("[]\n"))
+		})
+	})
+})
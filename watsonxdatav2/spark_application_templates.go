@@ -0,0 +1,464 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// Defaults used by SubmitSparkApplicationBatch.
+const (
+	defaultSparkBatchConcurrency  = 4
+	defaultSparkBatchPollInterval = 10 * time.Second
+	defaultSparkBatchWaitTimeout  = time.Hour
+)
+
+// sparkTemplatePlaceholder matches ${name} placeholders and the $$ escape.
+var sparkTemplatePlaceholder = regexp.MustCompile(`\$\$|\$\{([^}]*)\}`)
+
+// sparkTemplateParameterName matches a valid placeholder name.
+var sparkTemplateParameterName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// sparkApplicationTerminalStates are the application states that do not change any more.
+var sparkApplicationTerminalStates = map[string]bool{
+	"error":    true,
+	"failed":   true,
+	"finished": true,
+	"killed":   true,
+	"stopped":  true,
+}
+
+// SparkApplicationTemplate : A named Spark application with ${name} placeholders.
+// Placeholders may appear in any string of the application details, including arguments and conf. $$ stands for a
+// literal $.
+type SparkApplicationTemplate struct {
+	// Template name.
+	Name string `json:"name"`
+
+	// Default parameter values.
+	Defaults map[string]string `json:"defaults,omitempty"`
+
+	// Application details with placeholders.
+	ApplicationDetails *SparkApplicationDetails `json:"application_details"`
+}
+
+// LoadSparkApplicationTemplate : Read a Spark application template from a JSON file
+// The template name defaults to the file name without its extension.
+func LoadSparkApplicationTemplate(path string) (template *SparkApplicationTemplate, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "template-file-error", common.GetComponentInfo())
+		return
+	}
+	defer file.Close()
+	template, err = ReadSparkApplicationTemplate(file)
+	if err == nil && template.Name == "" {
+		template.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return
+}
+
+// LoadSparkApplicationTemplates : Read every *.json Spark application template in a directory, keyed by name
+func LoadSparkApplicationTemplates(dir string) (templates map[string]*SparkApplicationTemplate, err error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "template-file-error", common.GetComponentInfo())
+		return
+	}
+	templates = map[string]*SparkApplicationTemplate{}
+	for _, path := range paths {
+		var template *SparkApplicationTemplate
+		template, err = LoadSparkApplicationTemplate(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := templates[template.Name]; exists {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("duplicate template name '%s' in %s", template.Name, path), "template-file-error", common.GetComponentInfo())
+		}
+		templates[template.Name] = template
+	}
+	return
+}
+
+// ReadSparkApplicationTemplate : Read a Spark application template in JSON format
+// The template is validated.
+func ReadSparkApplicationTemplate(reader io.Reader) (template *SparkApplicationTemplate, err error) {
+	template = new(SparkApplicationTemplate)
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(template); err != nil {
+		return nil, core.SDKErrorf(err, "", "template-file-error", common.GetComponentInfo())
+	}
+	if err = template.Validate(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Validate : Check that the template has application details and well-formed placeholders.
+func (template *SparkApplicationTemplate) Validate() error {
+	if template.ApplicationDetails == nil {
+		return core.SDKErrorf(nil, fmt.Sprintf("template '%s' has no application details", template.Name), "invalid-template", common.GetComponentInfo())
+	}
+	_, err := template.parameters()
+	return err
+}
+
+// Parameters : Return the sorted names of the placeholders used by the template.
+func (template *SparkApplicationTemplate) Parameters() []string {
+	names, _ := template.parameters()
+	return names
+}
+
+// parameters returns the sorted placeholder names, or an error for a malformed one.
+func (template *SparkApplicationTemplate) parameters() (names []string, err error) {
+	seen := map[string]bool{}
+	_, err = template.substitute(func(name string) (string, error) {
+		if !sparkTemplateParameterName.MatchString(name) {
+			return "", fmt.Errorf("invalid placeholder '${%s}'", name)
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+		return "", nil
+	})
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("template '%s': %s", template.Name, err.Error()), "invalid-template", common.GetComponentInfo())
+	}
+	sort.Strings(names)
+	return
+}
+
+// Render : Substitute the parameters into the template
+// Parameters override the template defaults. Every placeholder must have a value.
+func (template *SparkApplicationTemplate) Render(parameters map[string]string) (details *SparkApplicationDetails, err error) {
+	var missing []string
+	details, err = template.substitute(func(name string) (string, error) {
+		if value, ok := parameters[name]; ok {
+			return value, nil
+		}
+		if value, ok := template.Defaults[name]; ok {
+			return value, nil
+		}
+		missing = append(missing, name)
+		return "", nil
+	})
+	if err == nil && len(missing) > 0 {
+		sort.Strings(missing)
+		err = fmt.Errorf("no value for parameters %s", strings.Join(missing, ", "))
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("template '%s': %s", template.Name, err.Error()), "template-render-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// substitute returns a copy of the application details with each placeholder replaced by lookup(name).
+func (template *SparkApplicationTemplate) substitute(lookup func(name string) (string, error)) (details *SparkApplicationDetails, err error) {
+	raw, err := json.Marshal(template.ApplicationDetails)
+	if err != nil {
+		return
+	}
+	var tree interface{}
+	if err = json.Unmarshal(raw, &tree); err != nil {
+		return
+	}
+
+	var walk func(value interface{}) (interface{}, error)
+	walk = func(value interface{}) (interface{}, error) {
+		switch value := value.(type) {
+		case string:
+			var lookupErr error
+			replaced := sparkTemplatePlaceholder.ReplaceAllStringFunc(value, func(match string) string {
+				if match == "$$" {
+					return "$"
+				}
+				replacement, err := lookup(match[2 : len(match)-1])
+				if err != nil && lookupErr == nil {
+					lookupErr = err
+				}
+				return replacement
+			})
+			return replaced, lookupErr
+		case []interface{}:
+			for i := range value {
+				item, err := walk(value[i])
+				if err != nil {
+					return nil, err
+				}
+				value[i] = item
+			}
+		case map[string]interface{}:
+			for key := range value {
+				item, err := walk(value[key])
+				if err != nil {
+					return nil, err
+				}
+				value[key] = item
+			}
+		}
+		return value, nil
+	}
+	if tree, err = walk(tree); err != nil {
+		return
+	}
+
+	if raw, err = json.Marshal(tree); err != nil {
+		return
+	}
+	details = new(SparkApplicationDetails)
+	err = json.Unmarshal(raw, details)
+	return
+}
+
+// SparkApplicationRun : The outcome of one run of SubmitSparkApplicationBatch.
+type SparkApplicationRun struct {
+	// Parameters of the run.
+	Parameters map[string]string
+
+	// Rendered application details, if rendering succeeded.
+	ApplicationDetails *SparkApplicationDetails
+
+	// Latest application status, if the submission succeeded.
+	Status *SparkEngineApplicationStatus
+
+	// Error rendering, submitting or waiting for the run.
+	Err error
+}
+
+// SparkApplicationBatchResult : Outcome of SubmitSparkApplicationBatch.
+type SparkApplicationBatchResult struct {
+	// Runs in the order of SubmitSparkApplicationBatchOptions.Runs.
+	Runs []SparkApplicationRun
+
+	// Number of runs with an error.
+	Failed int64
+}
+
+// SubmitSparkApplicationBatch : Submit parameterized runs of a Spark application template
+// Each entry of Runs is rendered with the template and submitted with CreateSparkEngineApplication, at most
+// Concurrency at a time. With Wait set, each application is polled until it reaches a terminal state. Errors of
+// individual runs are recorded in the result rather than returned.
+func (watsonxData *WatsonxDataV2) SubmitSparkApplicationBatch(submitSparkApplicationBatchOptions *SubmitSparkApplicationBatchOptions) (result *SparkApplicationBatchResult, err error) {
+	result, err = watsonxData.SubmitSparkApplicationBatchWithContext(context.Background(), submitSparkApplicationBatchOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SubmitSparkApplicationBatchWithContext is an alternate form of the SubmitSparkApplicationBatch method which supports a Context parameter
+func (watsonxData *WatsonxDataV2) SubmitSparkApplicationBatchWithContext(ctx context.Context, submitSparkApplicationBatchOptions *SubmitSparkApplicationBatchOptions) (result *SparkApplicationBatchResult, err error) {
+	err = core.ValidateNotNil(submitSparkApplicationBatchOptions, "submitSparkApplicationBatchOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(submitSparkApplicationBatchOptions, "submitSparkApplicationBatchOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	opts := submitSparkApplicationBatchOptions
+	if err = opts.Template.Validate(); err != nil {
+		return
+	}
+	concurrency := int64(defaultSparkBatchConcurrency)
+	if opts.Concurrency != nil && *opts.Concurrency > 0 {
+		concurrency = *opts.Concurrency
+	}
+
+	result = &SparkApplicationBatchResult{
+		Runs: make([]SparkApplicationRun, len(opts.Runs)),
+	}
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range opts.Runs {
+		wg.Add(1)
+		go func(run *SparkApplicationRun, parameters map[string]string) {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				run.Parameters = parameters
+				run.Err = ctx.Err()
+				return
+			}
+			defer func() { <-slots }()
+			*run = watsonxData.submitSparkApplicationRun(ctx, opts, parameters)
+		}(&result.Runs[i], opts.Runs[i])
+	}
+	wg.Wait()
+
+	for _, run := range result.Runs {
+		if run.Err != nil {
+			result.Failed++
+		}
+	}
+	return
+}
+
+// submitSparkApplicationRun renders, submits and optionally waits for one run.
+func (watsonxData *WatsonxDataV2) submitSparkApplicationRun(ctx context.Context, opts *SubmitSparkApplicationBatchOptions, parameters map[string]string) (run SparkApplicationRun) {
+	run.Parameters = parameters
+	run.ApplicationDetails, run.Err = opts.Template.Render(parameters)
+	if run.Err != nil {
+		return
+	}
+	run.Status, _, run.Err = watsonxData.CreateSparkEngineApplicationWithContext(ctx, &CreateSparkEngineApplicationOptions{
+		EngineID:           opts.EngineID,
+		ApplicationDetails: run.ApplicationDetails,
+		AuthInstanceID:     opts.AuthInstanceID,
+		Headers:            opts.Headers,
+	})
+	if run.Err != nil || opts.Wait == nil || !*opts.Wait {
+		return
+	}
+	applicationID := run.Status.ApplicationID
+	if applicationID == nil {
+		applicationID = run.Status.ID
+	}
+	if applicationID == nil {
+		run.Err = core.SDKErrorf(nil, "the submitted application has no ID", "missing-application-id", common.GetComponentInfo())
+		return
+	}
+
+	pollInterval := durationOrDefault(opts.PollInterval, defaultSparkBatchPollInterval)
+	waitTimeout := durationOrDefault(opts.WaitTimeout, defaultSparkBatchWaitTimeout)
+	run.Err = pollUntil(ctx, pollInterval, waitTimeout, func() (bool, error) {
+		if sparkApplicationTerminalStates[strings.ToLower(core.StringNilMapper(run.Status.State))] {
+			return true, nil
+		}
+		status, _, err := watsonxData.GetSparkEngineApplicationStatusWithContext(ctx, &GetSparkEngineApplicationStatusOptions{
+			EngineID:       opts.EngineID,
+			ApplicationID:  applicationID,
+			AuthInstanceID: opts.AuthInstanceID,
+			Headers:        opts.Headers,
+		})
+		if err != nil {
+			return false, err
+		}
+		run.Status = status
+		return sparkApplicationTerminalStates[strings.ToLower(core.StringNilMapper(status.State))], nil
+	})
+	return
+}
+
+// SubmitSparkApplicationBatchOptions : The SubmitSparkApplicationBatch options.
+type SubmitSparkApplicationBatchOptions struct {
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// Template of the application.
+	Template *SparkApplicationTemplate `json:"template" validate:"required"`
+
+	// Parameters of each run.
+	Runs []map[string]string `json:"runs" validate:"required,min=1"`
+
+	// Maximum number of runs submitted or waited for at the same time. Defaults to 4.
+	Concurrency *int64 `json:"concurrency,omitempty"`
+
+	// Wait for each application to reach a terminal state.
+	Wait *bool `json:"wait,omitempty"`
+
+	// Time between status checks while waiting. Defaults to 10 seconds.
+	PollInterval *time.Duration `json:"poll_interval,omitempty"`
+
+	// Maximum time to wait for each application. Defaults to 1 hour.
+	WaitTimeout *time.Duration `json:"wait_timeout,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSubmitSparkApplicationBatchOptions : Instantiate SubmitSparkApplicationBatchOptions
+func (*WatsonxDataV2) NewSubmitSparkApplicationBatchOptions(engineID string, template *SparkApplicationTemplate, runs []map[string]string) *SubmitSparkApplicationBatchOptions {
+	return &SubmitSparkApplicationBatchOptions{
+		EngineID: core.StringPtr(engineID),
+		Template: template,
+		Runs:     runs,
+	}
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *SubmitSparkApplicationBatchOptions) SetEngineID(engineID string) *SubmitSparkApplicationBatchOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetTemplate : Allow user to set Template
+func (_options *SubmitSparkApplicationBatchOptions) SetTemplate(template *SparkApplicationTemplate) *SubmitSparkApplicationBatchOptions {
+	_options.Template = template
+	return _options
+}
+
+// SetRuns : Allow user to set Runs
+func (_options *SubmitSparkApplicationBatchOptions) SetRuns(runs []map[string]string) *SubmitSparkApplicationBatchOptions {
+	_options.Runs = runs
+	return _options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *SubmitSparkApplicationBatchOptions) SetConcurrency(concurrency int64) *SubmitSparkApplicationBatchOptions {
+	_options.Concurrency = core.Int64Ptr(concurrency)
+	return _options
+}
+
+// SetWait : Allow user to set Wait
+func (_options *SubmitSparkApplicationBatchOptions) SetWait(wait bool) *SubmitSparkApplicationBatchOptions {
+	_options.Wait = core.BoolPtr(wait)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *SubmitSparkApplicationBatchOptions) SetPollInterval(pollInterval time.Duration) *SubmitSparkApplicationBatchOptions {
+	_options.PollInterval = &pollInterval
+	return _options
+}
+
+// SetWaitTimeout : Allow user to set WaitTimeout
+func (_options *SubmitSparkApplicationBatchOptions) SetWaitTimeout(waitTimeout time.Duration) *SubmitSparkApplicationBatchOptions {
+	_options.WaitTimeout = &waitTimeout
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *SubmitSparkApplicationBatchOptions) SetAuthInstanceID(authInstanceID string) *SubmitSparkApplicationBatchOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SubmitSparkApplicationBatchOptions) SetHeaders(param map[string]string) *SubmitSparkApplicationBatchOptions {
+	options.Headers = param
+	return options
+}
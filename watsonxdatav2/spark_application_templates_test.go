@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 Spark application templates`, func() {
+	const rollupTemplate = `{
+  "defaults": {"table": "sales", "partitions": "200"},
+  "application_details": {
+    "application": "s3://apps/rollup.py",
+    "name": "rollup-${table}-${date}",
+    "arguments": ["--date", "${date}", "--table", "${table}", "--cost", "$$5"],
+    "conf": {"spark_sample_config_properpty": "${date}", "spark.sql.shuffle.partitions": "${partitions}"},
+    "env": {"RUN_DATE": "${date}", "LOG_LEVEL": "INFO"}
+  }
+}`
+	Describe(`SparkApplicationTemplate`, func() {
+		It(`Load templates from a directory and render them`, func() {
+			dir, err := os.MkdirTemp("", "spark-templates")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+			Expect(os.WriteFile(filepath.Join(dir, "rollup.json"), []byte(rollupTemplate), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0600)).To(Succeed())
+
+			templates, err := watsonxdatav2.LoadSparkApplicationTemplates(dir)
+			Expect(err).To(BeNil())
+			Expect(templates).To(HaveLen(1))
+			template := templates["rollup"]
+			Expect(template.Name).To(Equal("rollup"))
+			Expect(template.Parameters()).To(Equal([]string{"date", "partitions", "table"}))
+			Expect(template.ApplicationDetails.Conf.GetProperty("spark.sql.shuffle.partitions")).To(Equal("${partitions}"))
+
+			details, err := template.Render(map[string]string{"date": "2025-06-06"})
+			Expect(err).To(BeNil())
+			Expect(details.Name).To(Equal(core.StringPtr("rollup-sales-2025-06-06")))
+			Expect(details.Arguments).To(Equal([]string{"--date", "2025-06-06", "--table", "sales", "--cost", "$5"}))
+			Expect(details.Conf.GetProperties()).To(Equal(map[string]string{"spark_sample_config_properpty": "2025-06-06", "spark.sql.shuffle.partitions": "200"}))
+			Expect(details.Env.GetProperties()).To(Equal(map[string]string{"RUN_DATE": "2025-06-06", "LOG_LEVEL": "INFO"}))
+			Expect(template.ApplicationDetails.Arguments[1]).To(Equal("${date}"))
+
+			_, err = template.Render(nil)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("no value for parameters date"))
+
+			for _, content := range []string{
+				`{"application_details": {"name": "${bad name}"}}`,
+				`{"name": "empty"}`,
+				`{"application_details": {}, "unknown": 1}`,
+				`{"application_details": {"application": "a.py", "unknown": 1}}`,
+				`{"application_details": {"application": "a.py", "env": ["X=1"]}}`,
+			} {
+				_, err = watsonxdatav2.ReadSparkApplicationTemplate(strings.NewReader(content))
+				Expect(err).ToNot(BeNil(), content)
+			}
+		})
+	})
+	Describe(`SubmitSparkApplicationBatch(submitSparkApplicationBatchOptions *SubmitSparkApplicationBatchOptions)`, func() {
+		var testServer *httptest.Server
+		var mutex sync.Mutex
+		var active, maxActive, polls int
+		BeforeEach(func() {
+			active, maxActive, polls = 0, 0, 0
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				res.Header().Set("Content-type", "application/json")
+				switch {
+				case req.Method == "POST" && req.URL.EscapedPath() == "/spark_engines/spark01/applications":
+					mutex.Lock()
+					active++
+					if active > maxActive {
+						maxActive = active
+					}
+					mutex.Unlock()
+					time.Sleep(5 * time.Millisecond)
+					var body map[string]map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					name := body["application_details"]["name"].(string)
+					Expect(body["application_details"]["env"]).To(HaveKeyWithValue("LOG_LEVEL", "INFO"))
+					mutex.Lock()
+					active--
+					mutex.Unlock()
+					if strings.Contains(name, "-bad-") {
+						res.WriteHeader(400)
+						fmt.Fprint(res, `{"errors": [{"code": "bad_request", "message": "bad table"}]}`)
+						return
+					}
+					res.WriteHeader(201)
+					fmt.Fprintf(res, `{"application_id": "%s", "state": "accepted"}`, name)
+				case req.Method == "GET" && strings.HasPrefix(req.URL.EscapedPath(), "/spark_engines/spark01/applications/"):
+					mutex.Lock()
+					polls++
+					mutex.Unlock()
+					fmt.Fprint(res, `{"application_id": "x", "state": "FINISHED", "return_code": "0"}`)
+				default:
+					Fail("unexpected request " + req.Method + " " + req.URL.EscapedPath())
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		It(`Submit runs with a concurrency limit and wait for them`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// Invoke operation with nil options model (negative test)
+			result, operationErr := watsonxDataService.SubmitSparkApplicationBatch(nil)
+			Expect(operationErr).ToNot(BeNil())
+			Expect(result).To(BeNil())
+
+			template, err := watsonxdatav2.ReadSparkApplicationTemplate(strings.NewReader(rollupTemplate))
+			Expect(err).To(BeNil())
+			runs := []map[string]string{
+				{"date": "2025-06-01"},
+				{"date": "2025-06-02"},
+				{"date": "2025-06-03", "table": "bad"},
+				{"table": "orders"},
+				{"date": "2025-06-05"},
+			}
+			submitSparkApplicationBatchOptionsModel := watsonxDataService.NewSubmitSparkApplicationBatchOptions("spark01", template, runs)
+			submitSparkApplicationBatchOptionsModel.SetConcurrency(2)
+			submitSparkApplicationBatchOptionsModel.SetWait(true)
+			submitSparkApplicationBatchOptionsModel.SetPollInterval(time.Millisecond)
+			result, operationErr = watsonxDataService.SubmitSparkApplicationBatch(submitSparkApplicationBatchOptionsModel)
+			Expect(operationErr).To(BeNil())
+			Expect(result.Runs).To(HaveLen(5))
+			Expect(result.Failed).To(Equal(int64(2)))
+			Expect(maxActive).To(BeNumerically("<=", 2))
+			Expect(polls).To(Equal(3))
+
+			Expect(result.Runs[0].ApplicationDetails.Name).To(Equal(core.StringPtr("rollup-sales-2025-06-01")))
+			Expect(result.Runs[0].Status.State).To(Equal(core.StringPtr("FINISHED")))
+			Expect(result.Runs[2].Err).ToNot(BeNil())
+			Expect(result.Runs[3].Err.Error()).To(ContainSubstring("no value for parameters date"))
+			Expect(result.Runs[3].Status).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,347 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// Defaults used by SparkHistoryServerManager.
+const (
+	defaultSparkHistoryServerRenewBefore   = 5 * time.Minute
+	defaultSparkHistoryServerCheckInterval = time.Minute
+)
+
+// sparkTimeLayouts are the layouts of the time strings returned for Spark resources.
+var sparkTimeLayouts = []string{
+	time.RFC3339Nano,
+	"Monday 2 January 2006 15:04:05.000-0700",
+	"Monday 2 January 2006 15:04:05-0700",
+}
+
+// parseSparkTime parses a Spark time string such as "2020-12-08T10:00:00.000Z" or
+// "Saturday 28 October 2023 07:17:06.856+0000".
+func parseSparkTime(value string) (t time.Time, ok bool) {
+	for _, layout := range sparkTimeLayouts {
+		var err error
+		if t, err = time.Parse(layout, strings.TrimSpace(value)); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// SparkHistoryServerAutoTerminationTime : Return the parsed AutoTerminationTime of the history server.
+// ok is false if the time is missing or not in a known format.
+func SparkHistoryServerAutoTerminationTime(server *SparkHistoryServer) (t time.Time, ok bool) {
+	if server == nil || server.AutoTerminationTime == nil {
+		return
+	}
+	return parseSparkTime(*server.AutoTerminationTime)
+}
+
+// SparkHistoryServerManager : Keeps the history server of a Spark engine running while callers hold leases.
+// The server is started when needed, restarted before it auto-terminates if a lease outlives it, and stopped when
+// the last lease is released or expires. A server the manager did not start is never restarted or stopped. Call Run, or Reconcile periodically, to handle expiry and auto-termination.
+type SparkHistoryServerManager struct {
+	service *WatsonxDataV2
+	options SparkHistoryServerManagerOptions
+
+	// mutex guards leases. reconcileMutex serializes Reconcile, which calls the service without holding mutex, and
+	// guards active, set while the server runs because this manager started it.
+	mutex          sync.Mutex
+	leases         map[*SparkHistoryServerLease]time.Time
+	reconcileMutex sync.Mutex
+	active         bool
+}
+
+// SparkHistoryServerLease : A claim that the history server stays running until it expires or is released.
+type SparkHistoryServerLease struct {
+	manager *SparkHistoryServerManager
+}
+
+// NewSparkHistoryServerManager : Instantiate SparkHistoryServerManager
+func (watsonxData *WatsonxDataV2) NewSparkHistoryServerManager(sparkHistoryServerManagerOptions *SparkHistoryServerManagerOptions) (manager *SparkHistoryServerManager, err error) {
+	err = core.ValidateNotNil(sparkHistoryServerManagerOptions, "sparkHistoryServerManagerOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(sparkHistoryServerManagerOptions, "sparkHistoryServerManagerOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	manager = &SparkHistoryServerManager{
+		service: watsonxData,
+		options: *sparkHistoryServerManagerOptions,
+		leases:  map[*SparkHistoryServerLease]time.Time{},
+	}
+	return
+}
+
+// Acquire : Ensure the history server runs for at least duration
+// The server is started if it is not running. Release the lease when done with the server.
+func (manager *SparkHistoryServerManager) Acquire(ctx context.Context, duration time.Duration) (lease *SparkHistoryServerLease, err error) {
+	now := time.Now()
+	lease = &SparkHistoryServerLease{
+		manager: manager,
+	}
+	manager.mutex.Lock()
+	manager.leases[lease] = now.Add(duration)
+	manager.mutex.Unlock()
+
+	if err = manager.Reconcile(ctx, now); err != nil {
+		manager.mutex.Lock()
+		delete(manager.leases, lease)
+		manager.mutex.Unlock()
+		return nil, err
+	}
+	return
+}
+
+// Extend : Keep the lease until duration from now.
+func (lease *SparkHistoryServerLease) Extend(ctx context.Context, duration time.Duration) error {
+	now := time.Now()
+	lease.manager.mutex.Lock()
+	if _, held := lease.manager.leases[lease]; !held {
+		lease.manager.mutex.Unlock()
+		return core.SDKErrorf(nil, "the lease has been released or has expired", "lease-not-held", common.GetComponentInfo())
+	}
+	lease.manager.leases[lease] = now.Add(duration)
+	lease.manager.mutex.Unlock()
+	return lease.manager.Reconcile(ctx, now)
+}
+
+// Release : Give up the lease
+// The history server is stopped if this was the last lease. Releasing a lease twice has no effect.
+func (lease *SparkHistoryServerLease) Release(ctx context.Context) error {
+	lease.manager.mutex.Lock()
+	delete(lease.manager.leases, lease)
+	lease.manager.mutex.Unlock()
+	return lease.manager.Reconcile(ctx, time.Now())
+}
+
+// Leases : Return the number of leases held.
+func (manager *SparkHistoryServerManager) Leases() int {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	return len(manager.leases)
+}
+
+// Run : Reconcile every CheckInterval until ctx is done
+// Errors are passed to onError, if set, and do not stop the loop. Returns the context error.
+func (manager *SparkHistoryServerManager) Run(ctx context.Context, onError func(error)) error {
+	interval := durationOrDefault(manager.options.CheckInterval, defaultSparkHistoryServerCheckInterval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			if err := manager.Reconcile(ctx, now); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// Reconcile : Bring the history server in line with the leases held at now
+// Expired leases are dropped. Without leases, a server this manager started is stopped. With leases, a stopped
+// server is started, and a server this manager started that auto-terminates within RenewBefore while a lease
+// outlives it is restarted. A server that was already running is left alone until it stops, after which the
+// manager starts its own.
+func (manager *SparkHistoryServerManager) Reconcile(ctx context.Context, now time.Time) (err error) {
+	manager.reconcileMutex.Lock()
+	defer manager.reconcileMutex.Unlock()
+
+	manager.mutex.Lock()
+	var until time.Time
+	for lease, expiry := range manager.leases {
+		if !expiry.After(now) {
+			delete(manager.leases, lease)
+		} else if expiry.After(until) {
+			until = expiry
+		}
+	}
+	leases := len(manager.leases)
+	manager.mutex.Unlock()
+
+	if leases == 0 {
+		if manager.active {
+			if err = manager.stop(ctx); err != nil {
+				return
+			}
+			manager.active = false
+		}
+		return
+	}
+
+	server, running, err := manager.get(ctx)
+	if err != nil {
+		return
+	}
+	if running && manager.active {
+		autoTermination, ok := SparkHistoryServerAutoTerminationTime(server)
+		renewBefore := durationOrDefault(manager.options.RenewBefore, defaultSparkHistoryServerRenewBefore)
+		if ok && until.After(autoTermination) && !now.Add(renewBefore).Before(autoTermination) {
+			// There is no API to extend the auto-termination time, so start a fresh server.
+			if err = manager.stop(ctx); err != nil {
+				return
+			}
+			running = false
+		}
+	}
+	if !running {
+		if err = manager.start(ctx); err != nil {
+			return
+		}
+		manager.active = true
+	}
+	return
+}
+
+// get returns the history server and whether it is running. A missing server is not running.
+func (manager *SparkHistoryServerManager) get(ctx context.Context) (server *SparkHistoryServer, running bool, err error) {
+	server, response, err := manager.service.GetSparkEngineHistoryServerWithContext(ctx, &GetSparkEngineHistoryServerOptions{
+		EngineID:       manager.options.EngineID,
+		AuthInstanceID: manager.options.AuthInstanceID,
+		Headers:        manager.options.Headers,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, false, nil
+		}
+		err = core.RepurposeSDKProblem(err, "history-server-error")
+		return
+	}
+	switch strings.ToLower(core.StringNilMapper(server.State)) {
+	case "active", "running", "started", "starting":
+		running = true
+	}
+	return
+}
+
+// start starts the history server with the configured resources.
+func (manager *SparkHistoryServerManager) start(ctx context.Context) error {
+	_, _, err := manager.service.StartSparkEngineHistoryServerWithContext(ctx, &StartSparkEngineHistoryServerOptions{
+		EngineID:       manager.options.EngineID,
+		Cores:          manager.options.Cores,
+		Memory:         manager.options.Memory,
+		AuthInstanceID: manager.options.AuthInstanceID,
+		Headers:        manager.options.Headers,
+	})
+	if err != nil {
+		return core.SDKErrorf(err, fmt.Sprintf("starting the history server of engine %s failed: %s", *manager.options.EngineID, err.Error()), "history-server-start-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// stop stops the history server. A server that is already gone is not an error.
+func (manager *SparkHistoryServerManager) stop(ctx context.Context) error {
+	response, err := manager.service.DeleteSparkEngineHistoryServerWithContext(ctx, &DeleteSparkEngineHistoryServerOptions{
+		EngineID:       manager.options.EngineID,
+		AuthInstanceID: manager.options.AuthInstanceID,
+		Headers:        manager.options.Headers,
+	})
+	if err != nil && (response == nil || response.StatusCode != http.StatusNotFound) {
+		return core.SDKErrorf(err, fmt.Sprintf("stopping the history server of engine %s failed: %s", *manager.options.EngineID, err.Error()), "history-server-stop-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// SparkHistoryServerManagerOptions : The NewSparkHistoryServerManager options.
+type SparkHistoryServerManagerOptions struct {
+	// engine id.
+	EngineID *string `json:"engine_id" validate:"required,ne="`
+
+	// CPU count of the history server.
+	Cores *string `json:"cores,omitempty"`
+
+	// Memory of the history server in GiB.
+	Memory *string `json:"memory,omitempty"`
+
+	// Restart the server when it auto-terminates within this time and a lease lasts longer. Defaults to 5 minutes.
+	RenewBefore *time.Duration `json:"renew_before,omitempty"`
+
+	// Time between reconciliations in Run. Defaults to 1 minute.
+	CheckInterval *time.Duration `json:"check_interval,omitempty"`
+
+	// CRN.
+	AuthInstanceID *string `json:"AuthInstanceId,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSparkHistoryServerManagerOptions : Instantiate SparkHistoryServerManagerOptions
+func (*WatsonxDataV2) NewSparkHistoryServerManagerOptions(engineID string) *SparkHistoryServerManagerOptions {
+	return &SparkHistoryServerManagerOptions{
+		EngineID: core.StringPtr(engineID),
+	}
+}
+
+// SetEngineID : Allow user to set EngineID
+func (_options *SparkHistoryServerManagerOptions) SetEngineID(engineID string) *SparkHistoryServerManagerOptions {
+	_options.EngineID = core.StringPtr(engineID)
+	return _options
+}
+
+// SetCores : Allow user to set Cores
+func (_options *SparkHistoryServerManagerOptions) SetCores(cores string) *SparkHistoryServerManagerOptions {
+	_options.Cores = core.StringPtr(cores)
+	return _options
+}
+
+// SetMemory : Allow user to set Memory
+func (_options *SparkHistoryServerManagerOptions) SetMemory(memory string) *SparkHistoryServerManagerOptions {
+	_options.Memory = core.StringPtr(memory)
+	return _options
+}
+
+// SetRenewBefore : Allow user to set RenewBefore
+func (_options *SparkHistoryServerManagerOptions) SetRenewBefore(renewBefore time.Duration) *SparkHistoryServerManagerOptions {
+	_options.RenewBefore = &renewBefore
+	return _options
+}
+
+// SetCheckInterval : Allow user to set CheckInterval
+func (_options *SparkHistoryServerManagerOptions) SetCheckInterval(checkInterval time.Duration) *SparkHistoryServerManagerOptions {
+	_options.CheckInterval = &checkInterval
+	return _options
+}
+
+// SetAuthInstanceID : Allow user to set AuthInstanceID
+func (_options *SparkHistoryServerManagerOptions) SetAuthInstanceID(authInstanceID string) *SparkHistoryServerManagerOptions {
+	_options.AuthInstanceID = core.StringPtr(authInstanceID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SparkHistoryServerManagerOptions) SetHeaders(param map[string]string) *SparkHistoryServerManagerOptions {
+	options.Headers = param
+	return options
+}
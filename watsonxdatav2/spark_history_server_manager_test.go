@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 Spark history server manager`, func() {
+	var testServer *httptest.Server
+	var calls []string
+	var running bool
+	var autoTermination time.Time
+	BeforeEach(func() {
+		calls = nil
+		running = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/spark_engines/spark01/history_server"))
+			res.Header().Set("Content-type", "application/json")
+			if req.Method != "GET" {
+				calls = append(calls, req.Method)
+			}
+			switch req.Method {
+			case "GET":
+				if !running {
+					res.WriteHeader(404)
+					fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "history server not started"}]}`)
+					return
+				}
+				fmt.Fprintf(res, `{"state": "started", "auto_termination_time": "%s"}`, autoTermination.UTC().Format("2006-01-02T15:04:05.000Z"))
+			case "POST":
+				running = true
+				autoTermination = time.Now().Add(time.Hour)
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"state": "started"}`)
+			case "DELETE":
+				running = false
+				res.WriteHeader(204)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Parse Spark times`, func() {
+		t, ok := watsonxdatav2.SparkHistoryServerAutoTerminationTime(&watsonxdatav2.SparkHistoryServer{
+			AutoTerminationTime: core.StringPtr("2020-12-08T10:00:00.000Z"),
+		})
+		Expect(ok).To(BeTrue())
+		Expect(t).To(BeTemporally("==", time.Date(2020, 12, 8, 10, 0, 0, 0, time.UTC)))
+		t, ok = watsonxdatav2.SparkHistoryServerAutoTerminationTime(&watsonxdatav2.SparkHistoryServer{
+			AutoTerminationTime: core.StringPtr("Saturday 28 October 2023 07:17:06.856+0000"),
+		})
+		Expect(ok).To(BeTrue())
+		Expect(t).To(BeTemporally("==", time.Date(2023, 10, 28, 7, 17, 6, 856000000, time.UTC)))
+		_, ok = watsonxdatav2.SparkHistoryServerAutoTerminationTime(&watsonxdatav2.SparkHistoryServer{})
+		Expect(ok).To(BeFalse())
+	})
+	It(`Start, renew and stop the history server with leases`, func() {
+		watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		// Invoke operation with nil options model (negative test)
+		manager, err := watsonxDataService.NewSparkHistoryServerManager(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(manager).To(BeNil())
+
+		sparkHistoryServerManagerOptionsModel := watsonxDataService.NewSparkHistoryServerManagerOptions("spark01")
+		sparkHistoryServerManagerOptionsModel.SetCores("1")
+		sparkHistoryServerManagerOptionsModel.SetMemory("4G")
+		manager, err = watsonxDataService.NewSparkHistoryServerManager(sparkHistoryServerManagerOptionsModel)
+		Expect(err).To(BeNil())
+
+		first, err := manager.Acquire(context.Background(), 30*time.Minute)
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"POST"}))
+
+		second, err := manager.Acquire(context.Background(), 3*time.Hour)
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"POST"}))
+		Expect(manager.Leases()).To(Equal(2))
+
+		// The server auto-terminates in an hour, so the three hour lease restarts it once that is close.
+		Expect(manager.Reconcile(context.Background(), time.Now().Add(50*time.Minute))).To(Succeed())
+		Expect(calls).To(Equal([]string{"POST"}))
+		Expect(manager.Reconcile(context.Background(), time.Now().Add(56*time.Minute))).To(Succeed())
+		Expect(calls).To(Equal([]string{"POST", "DELETE", "POST"}))
+		Expect(manager.Leases()).To(Equal(1))
+
+		Expect(first.Release(context.Background())).To(Succeed())
+		Expect(calls).To(HaveLen(3))
+		Expect(second.Release(context.Background())).To(Succeed())
+		Expect(calls).To(Equal([]string{"POST", "DELETE", "POST", "DELETE"}))
+		Expect(running).To(BeFalse())
+
+		Expect(second.Release(context.Background())).To(Succeed())
+		Expect(calls).To(HaveLen(4))
+		Expect(second.Extend(context.Background(), time.Hour)).ToNot(Succeed())
+	})
+	It(`Leave a server it did not start running`, func() {
+		watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		running = true
+		autoTermination = time.Now().Add(time.Hour)
+		manager, err := watsonxDataService.NewSparkHistoryServerManager(watsonxDataService.NewSparkHistoryServerManagerOptions("spark01"))
+		Expect(err).To(BeNil())
+		lease, err := manager.Acquire(context.Background(), 10*time.Minute)
+		Expect(err).To(BeNil())
+		Expect(lease.Release(context.Background())).To(Succeed())
+		Expect(calls).To(BeEmpty())
+		Expect(running).To(BeTrue())
+
+		// It is not restarted before it auto-terminates, even for a lease that outlives it.
+		lease, err = manager.Acquire(context.Background(), 3*time.Hour)
+		Expect(err).To(BeNil())
+		Expect(manager.Reconcile(context.Background(), time.Now().Add(56*time.Minute))).To(Succeed())
+		Expect(calls).To(BeEmpty())
+
+		// Once it has stopped, the manager starts its own server and stops it with the last lease.
+		running = false
+		Expect(manager.Reconcile(context.Background(), time.Now().Add(61*time.Minute))).To(Succeed())
+		Expect(calls).To(Equal([]string{"POST"}))
+		Expect(lease.Release(context.Background())).To(Succeed())
+		Expect(calls).To(Equal([]string{"POST", "DELETE"}))
+	})
+	It(`Stop the server when the last lease expires`, func() {
+		watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		sparkHistoryServerManagerOptionsModel := watsonxDataService.NewSparkHistoryServerManagerOptions("spark01")
+		sparkHistoryServerManagerOptionsModel.SetCheckInterval(5 * time.Millisecond)
+		manager, err := watsonxDataService.NewSparkHistoryServerManager(sparkHistoryServerManagerOptionsModel)
+		Expect(err).To(BeNil())
+		_, err = manager.Acquire(context.Background(), 10*time.Millisecond)
+		Expect(err).To(BeNil())
+		Expect(running).To(BeTrue())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		Expect(manager.Run(ctx, nil)).To(Equal(context.DeadlineExceeded))
+		Expect(running).To(BeFalse())
+		Expect(manager.Leases()).To(BeZero())
+	})
+})
@@ -0,0 +1,356 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/watsonxdata-go-sdk/common"
+)
+
+// sparkPackagePattern matches a Maven coordinate, groupId:artifactId:version.
+var sparkPackagePattern = regexp.MustCompile(`^[^:\s]+:[^:\s]+:[^:\s]+$`)
+
+// sparkSubmitIgnoredFlags are spark-submit flags that select the cluster manager. The engine decides these, so
+// their values are dropped.
+var sparkSubmitIgnoredFlags = map[string]bool{
+	"--deploy-mode": true,
+	"--master":      true,
+}
+
+// sparkSubmitValuelessFlags are spark-submit flags that take no value and do not change the application, so they
+// are dropped.
+var sparkSubmitValuelessFlags = map[string]bool{
+	"--supervise": true,
+	"--verbose":   true,
+	"-v":          true,
+}
+
+// sparkSubmitResourceFlags maps spark-submit flags to the Spark properties they set.
+var sparkSubmitResourceFlags = map[string]string{
+	"--archives":            "spark.archives",
+	"--driver-class-path":   "spark.driver.extraClassPath",
+	"--driver-cores":        "spark.driver.cores",
+	"--driver-java-options": "spark.driver.extraJavaOptions",
+	"--driver-library-path": "spark.driver.extraLibraryPath",
+	"--driver-memory":       "spark.driver.memory",
+	"--exclude-packages":    "spark.jars.excludes",
+	"--executor-cores":      "spark.executor.cores",
+	"--executor-memory":     "spark.executor.memory",
+	"--num-executors":       "spark.executor.instances",
+}
+
+// SparkSubmitApplication : A Spark application described by spark-submit arguments.
+type SparkSubmitApplication struct {
+	// Application file, the first positional argument.
+	Application string
+
+	// Arguments passed to the application.
+	Arguments []string
+
+	// Main class, from --class.
+	Class string
+
+	// Display name, from --name.
+	Name string
+
+	// Spark configuration, from --conf and the resource flags.
+	Conf map[string]string
+
+	// Jars, from --jars.
+	Jars []string
+
+	// Files, from --files.
+	Files []string
+
+	// Python files, from --py-files.
+	PyFiles []string
+
+	// Maven coordinates, from --packages.
+	Packages []string
+
+	// Maven repositories, from --repositories.
+	Repositories []string
+}
+
+// ParseSparkSubmitCommand : Parse a spark-submit command line
+// The command is split like a POSIX shell would: single and double quotes group words, a backslash escapes the
+// next character and a backslash before a newline continues the line. A leading spark-submit word is skipped.
+func ParseSparkSubmitCommand(command string) (*SparkSubmitApplication, error) {
+	args, err := splitSparkSubmitCommand(command)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-spark-submit-command", common.GetComponentInfo())
+	}
+	return ParseSparkSubmitArguments(args)
+}
+
+// ParseSparkSubmitArguments : Parse spark-submit arguments
+// Supported options are --class, --name, --conf (or -c) k=v, --properties-file, --jars, --files, --py-files,
+// --packages and --repositories, either as "--option value" or "--option=value". --archives, --driver-memory,
+// --driver-cores, --driver-java-options, --driver-class-path, --driver-library-path, --exclude-packages,
+// --executor-memory, --executor-cores and --num-executors set their spark.* properties in Conf, overriding --conf as
+// spark-submit does. The properties file is read when the arguments are parsed, and its entries only apply to
+// properties that no other option sets. --master, --deploy-mode, --supervise and --verbose are ignored. The first
+// positional argument is the application and the rest are its arguments. A leading spark-submit word is skipped.
+func ParseSparkSubmitArguments(args []string) (*SparkSubmitApplication, error) {
+	if len(args) > 0 && (args[0] == "spark-submit" || strings.HasSuffix(args[0], "/spark-submit")) {
+		args = args[1:]
+	}
+	app := &SparkSubmitApplication{
+		Conf: map[string]string{},
+	}
+	resources := map[string]string{}
+	var propertiesFile string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			app.Application = arg
+			app.Arguments = append([]string{}, args[i+1:]...)
+			break
+		}
+
+		flag, value, inline := strings.Cut(arg, "=")
+		if flag == "-c" {
+			flag = "--conf"
+		}
+		if sparkSubmitValuelessFlags[flag] {
+			if inline {
+				return nil, core.SDKErrorf(nil, fmt.Sprintf("%s takes no value", flag), "invalid-spark-submit-arguments", common.GetComponentInfo())
+			}
+			continue
+		}
+		if !inline {
+			if i+1 >= len(args) {
+				return nil, core.SDKErrorf(nil, fmt.Sprintf("missing value for %s", flag), "invalid-spark-submit-arguments", common.GetComponentInfo())
+			}
+			i++
+			value = args[i]
+		}
+		switch flag {
+		case "--class":
+			app.Class = value
+		case "--name":
+			app.Name = value
+		case "--properties-file":
+			propertiesFile = value
+		case "--conf":
+			key, confValue, ok := strings.Cut(value, "=")
+			if !ok || strings.TrimSpace(key) == "" {
+				return nil, core.SDKErrorf(nil, fmt.Sprintf("--conf expects key=value, got '%s'", value), "invalid-spark-submit-arguments", common.GetComponentInfo())
+			}
+			app.Conf[strings.TrimSpace(key)] = confValue
+		case "--jars":
+			app.Jars = append(app.Jars, splitSparkSubmitList(value)...)
+		case "--files":
+			app.Files = append(app.Files, splitSparkSubmitList(value)...)
+		case "--py-files":
+			app.PyFiles = append(app.PyFiles, splitSparkSubmitList(value)...)
+		case "--packages":
+			app.Packages = append(app.Packages, splitSparkSubmitList(value)...)
+		case "--repositories":
+			app.Repositories = append(app.Repositories, splitSparkSubmitList(value)...)
+		default:
+			if key, ok := sparkSubmitResourceFlags[flag]; ok {
+				resources[key] = value
+			} else if !sparkSubmitIgnoredFlags[flag] {
+				return nil, core.SDKErrorf(nil, fmt.Sprintf("unsupported spark-submit option %s", flag), "invalid-spark-submit-arguments", common.GetComponentInfo())
+			}
+		}
+	}
+	for key, value := range resources {
+		app.Conf[key] = value
+	}
+	if propertiesFile != "" {
+		defaults, err := readSparkPropertiesFile(propertiesFile)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range defaults {
+			if _, set := app.Conf[key]; !set {
+				app.Conf[key] = value
+			}
+		}
+	}
+	return app, nil
+}
+
+// Validate : Check the application for problems
+// The application file is required, --py-files needs a Python application and --packages entries must be
+// groupId:artifactId:version coordinates. All problems are reported in one error.
+func (app *SparkSubmitApplication) Validate() error {
+	var problems []string
+	if app.Application == "" {
+		problems = append(problems, "no application file")
+	}
+	if len(app.PyFiles) > 0 && !strings.HasSuffix(app.Application, ".py") {
+		problems = append(problems, "--py-files requires a Python application")
+	}
+	for _, pkg := range app.Packages {
+		if !sparkPackagePattern.MatchString(pkg) {
+			problems = append(problems, fmt.Sprintf("package '%s' is not groupId:artifactId:version", pkg))
+		}
+	}
+	if len(problems) > 0 {
+		return core.SDKErrorf(nil, "invalid Spark application: "+strings.Join(problems, "; "), "invalid-spark-submit-arguments", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ApplicationDetails : Convert the application to SparkApplicationDetails
+// Conf is set with SparkApplicationConfig.SetProperties. Python files are passed in the spark.submit.pyFiles
+// property, which puts them on the PYTHONPATH as --py-files does.
+func (app *SparkSubmitApplication) ApplicationDetails() (details *SparkApplicationDetails, err error) {
+	err = app.Validate()
+	if err != nil {
+		return
+	}
+	details = &SparkApplicationDetails{
+		Application:  core.StringPtr(app.Application),
+		Arguments:    app.Arguments,
+		Jars:         joinSparkSubmitList(app.Jars),
+		Files:        joinSparkSubmitList(app.Files),
+		Packages:     joinSparkSubmitList(app.Packages),
+		Repositories: joinSparkSubmitList(app.Repositories),
+	}
+	if app.Class != "" {
+		details.Class = core.StringPtr(app.Class)
+	}
+	if app.Name != "" {
+		details.Name = core.StringPtr(app.Name)
+	}
+	if len(app.Conf) > 0 || len(app.PyFiles) > 0 {
+		details.Conf = new(SparkApplicationConfig)
+		details.Conf.SetProperties(app.Conf)
+		if len(app.PyFiles) > 0 {
+			details.Conf.SetProperty("spark.submit.pyFiles", strings.Join(app.PyFiles, ","))
+		}
+	}
+	return
+}
+
+// NewCreateSparkEngineApplicationOptionsFromSparkSubmit : Instantiate CreateSparkEngineApplicationOptions from spark-submit arguments
+// The arguments are parsed with ParseSparkSubmitArguments and validated before the options are returned.
+func (watsonxData *WatsonxDataV2) NewCreateSparkEngineApplicationOptionsFromSparkSubmit(engineID string, args []string) (*CreateSparkEngineApplicationOptions, error) {
+	app, err := ParseSparkSubmitArguments(args)
+	if err != nil {
+		return nil, err
+	}
+	details, err := app.ApplicationDetails()
+	if err != nil {
+		return nil, err
+	}
+	options := watsonxData.NewCreateSparkEngineApplicationOptions(engineID, details)
+	err = core.ValidateStruct(options, "createSparkEngineApplicationOptions")
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	return options, nil
+}
+
+// readSparkPropertiesFile reads a Spark properties file such as spark-defaults.conf.
+func readSparkPropertiesFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "properties-file-error", common.GetComponentInfo())
+	}
+	defer file.Close()
+	values, err := readPropertiesFile(file)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("%s: %s", path, err.Error()), "properties-file-error", common.GetComponentInfo())
+	}
+	return values, nil
+}
+
+// splitSparkSubmitList splits a comma separated option value, dropping empty entries.
+func splitSparkSubmitList(value string) (items []string) {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return
+}
+
+// joinSparkSubmitList joins items with commas, or returns nil if there are none.
+func joinSparkSubmitList(items []string) *string {
+	if len(items) == 0 {
+		return nil
+	}
+	return core.StringPtr(strings.Join(items, ","))
+}
+
+// splitSparkSubmitCommand splits a command line into words with POSIX shell quoting rules.
+func splitSparkSubmitCommand(command string) (words []string, err error) {
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	runes := []rune(command)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else if r == '\\' && i+1 < len(runes) && strings.ContainsRune("\"\\$`\n", runes[i+1]) {
+				i++
+				if runes[i] != '\n' {
+					word.WriteRune(runes[i])
+				}
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\\':
+			if i+1 >= len(runes) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			i++
+			if runes[i] != '\n' {
+				word.WriteRune(runes[i])
+				inWord = true
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return
+}
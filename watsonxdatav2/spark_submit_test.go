@@ -0,0 +1,184 @@
+/**
+ * (C) Copyright IBM Corp. 2025.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package watsonxdatav2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/watsonxdata-go-sdk/watsonxdatav2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`WatsonxDataV2 spark-submit arguments`, func() {
+	Describe(`ParseSparkSubmitCommand`, func() {
+		It(`Parse a quoted, continued command line`, func() {
+			app, err := watsonxdatav2.ParseSparkSubmitCommand(`/opt/spark/bin/spark-submit --master yarn \
+  --name "daily rollup" --class com.example.Rollup \
+  --conf spark_sample_config_properpty=on --conf spark.sql.shuffle.partitions=200 \
+  --conf spark.executor.memory=2g --executor-memory 4g --num-executors=3 --driver-cores 2 \
+  --jars a.jar,b.jar --jars=c.jar \
+  --packages org.apache.iceberg:iceberg-spark-runtime-3.5_2.12:1.5.0 \
+  s3://apps/rollup.jar --date '2025-06-06' "a \"b\""`)
+			Expect(err).To(BeNil())
+			Expect(app.Name).To(Equal("daily rollup"))
+			Expect(app.Class).To(Equal("com.example.Rollup"))
+			Expect(app.Application).To(Equal("s3://apps/rollup.jar"))
+			Expect(app.Arguments).To(Equal([]string{"--date", "2025-06-06", `a "b"`}))
+			Expect(app.Conf).To(Equal(map[string]string{
+				"spark_sample_config_properpty": "on",
+				"spark.sql.shuffle.partitions":  "200",
+				"spark.executor.memory":         "4g",
+				"spark.executor.instances":      "3",
+				"spark.driver.cores":            "2",
+			}))
+			Expect(app.Jars).To(Equal([]string{"a.jar", "b.jar", "c.jar"}))
+
+			details, err := app.ApplicationDetails()
+			Expect(err).To(BeNil())
+			Expect(details.Application).To(Equal(core.StringPtr("s3://apps/rollup.jar")))
+			Expect(details.Class).To(Equal(core.StringPtr("com.example.Rollup")))
+			Expect(details.Jars).To(Equal(core.StringPtr("a.jar,b.jar,c.jar")))
+			Expect(details.Conf.GetProperties()).To(Equal(app.Conf))
+			Expect(details.Conf.SparkSampleConfigProperpty).To(Equal(core.StringPtr("on")))
+			body, err := json.Marshal(details)
+			Expect(err).To(BeNil())
+			Expect(string(body)).To(Equal(`{"application":"s3://apps/rollup.jar","arguments":["--date","2025-06-06","a \"b\""],"class":"com.example.Rollup",` +
+				`"conf":{"spark.driver.cores":"2","spark.executor.instances":"3","spark.executor.memory":"4g","spark.sql.shuffle.partitions":"200","spark_sample_config_properpty":"on"},` +
+				`"jars":"a.jar,b.jar,c.jar","name":"daily rollup","packages":"org.apache.iceberg:iceberg-spark-runtime-3.5_2.12:1.5.0"}`))
+
+			_, err = watsonxdatav2.ParseSparkSubmitCommand(`spark-submit --name 'open`)
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Reject invalid arguments`, func() {
+			for _, args := range [][]string{
+				{"--class"},
+				{"--conf", "novalue", "app.py"},
+				{"--keytab", "user.keytab", "app.py"},
+				{"--verbose=true", "app.py"},
+			} {
+				_, err := watsonxdatav2.ParseSparkSubmitArguments(args)
+				Expect(err).ToNot(BeNil(), args[0])
+			}
+
+			app, err := watsonxdatav2.ParseSparkSubmitArguments([]string{"--py-files", "lib.zip", "--packages", "bad", "-c", "spark.executor.memory=4g", "app.jar"})
+			Expect(err).To(BeNil())
+			err = app.Validate()
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("--py-files requires a Python application"))
+			Expect(err.Error()).To(ContainSubstring("package 'bad' is not groupId:artifactId:version"))
+			Expect(err.Error()).ToNot(ContainSubstring("spark.executor.memory"))
+
+			app, err = watsonxdatav2.ParseSparkSubmitArguments([]string{"--driver-memory", "4g", "-c", "spark.executor.memory=4g", "app.jar"})
+			Expect(err).To(BeNil())
+			Expect(app.Validate()).To(Succeed())
+
+			app, err = watsonxdatav2.ParseSparkSubmitArguments([]string{"--verbose", "--supervise", "-v", "app.jar", "--verbose"})
+			Expect(err).To(BeNil())
+			Expect(app.Application).To(Equal("app.jar"))
+			Expect(app.Arguments).To(Equal([]string{"--verbose"}))
+		})
+		It(`Map property-backed options and read a properties file`, func() {
+			for flag, property := range map[string]string{
+				"--archives":            "spark.archives",
+				"--driver-java-options": "spark.driver.extraJavaOptions",
+				"--driver-class-path":   "spark.driver.extraClassPath",
+				"--driver-library-path": "spark.driver.extraLibraryPath",
+				"--exclude-packages":    "spark.jars.excludes",
+			} {
+				app, err := watsonxdatav2.ParseSparkSubmitArguments([]string{"--conf", property + "=from-conf", flag, "from flag", "app.jar"})
+				Expect(err).To(BeNil(), flag)
+				Expect(app.Conf).To(Equal(map[string]string{property: "from flag"}), flag)
+			}
+
+			dir, err := os.MkdirTemp("", "spark-submit")
+			Expect(err).To(BeNil())
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "spark-defaults.conf")
+			Expect(os.WriteFile(path, []byte("# defaults\nspark.executor.memory   2g\nspark.eventLog.enabled=true\nspark.archives  env.tar.gz#env\n"), 0600)).To(Succeed())
+			app, err := watsonxdatav2.ParseSparkSubmitArguments([]string{"--properties-file", path, "--executor-memory", "4g", "--archives", "deps.zip", "app.jar"})
+			Expect(err).To(BeNil())
+			Expect(app.Conf).To(Equal(map[string]string{
+				"spark.executor.memory":  "4g",
+				"spark.eventLog.enabled": "true",
+				"spark.archives":         "deps.zip",
+			}))
+
+			_, err = watsonxdatav2.ParseSparkSubmitArguments([]string{"--properties-file", filepath.Join(dir, "missing.conf"), "app.jar"})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`NewCreateSparkEngineApplicationOptionsFromSparkSubmit(engineID string, args []string)`, func() {
+		It(`Build validated options`, func() {
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           "http://watsonxdatav2modelgenerator.com",
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			options, err := watsonxDataService.NewCreateSparkEngineApplicationOptionsFromSparkSubmit("spark01", []string{"--py-files", "lib.zip,util.py", "--files", "conf.json", "job.py", "x"})
+			Expect(err).To(BeNil())
+			Expect(options.EngineID).To(Equal(core.StringPtr("spark01")))
+			Expect(options.ApplicationDetails.Application).To(Equal(core.StringPtr("job.py")))
+			Expect(options.ApplicationDetails.Files).To(Equal(core.StringPtr("conf.json")))
+			Expect(options.ApplicationDetails.Conf.GetProperties()).To(Equal(map[string]string{"spark.submit.pyFiles": "lib.zip,util.py"}))
+
+			_, err = watsonxDataService.NewCreateSparkEngineApplicationOptionsFromSparkSubmit("spark01", []string{"--name", "no-app"})
+			Expect(err).ToNot(BeNil())
+			_, err = watsonxDataService.NewCreateSparkEngineApplicationOptionsFromSparkSubmit("", []string{"job.py"})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`CreateSparkEngineApplication(createSparkEngineApplicationOptions *CreateSparkEngineApplicationOptions)`, func() {
+		It(`Send every conf entry`, func() {
+			testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(req.Method).To(Equal("POST"))
+				Expect(req.URL.EscapedPath()).To(Equal("/spark_engines/spark01/applications"))
+				var body map[string]map[string]interface{}
+				Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+				Expect(body["application_details"]["conf"]).To(Equal(map[string]interface{}{
+					"spark.executor.memory": "4g",
+					"spark.submit.pyFiles":  "lib.zip",
+				}))
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"application_id": "app-1", "state": "accepted"}`)
+			}))
+			defer testServer.Close()
+			watsonxDataService, serviceErr := watsonxdatav2.NewWatsonxDataV2(&watsonxdatav2.WatsonxDataV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			options, err := watsonxDataService.NewCreateSparkEngineApplicationOptionsFromSparkSubmit("spark01", []string{"--executor-memory", "4g", "--py-files", "lib.zip", "job.py"})
+			Expect(err).To(BeNil())
+			result, response, operationErr := watsonxDataService.CreateSparkEngineApplication(options)
+			Expect(operationErr).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(result.ApplicationID).To(Equal(core.StringPtr("app-1")))
+		})
+	})
+})